@@ -0,0 +1,37 @@
+package messagebird
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// AccountInfo describes the account an access key belongs to.
+type AccountInfo struct {
+	Payment string      // Payment method, "prepaid" or "postpaid".
+	Type    string      // Balance type, e.g. "credits" or "euros".
+	Balance json.Number // Remaining balance.
+}
+
+// VerifyCredentials performs a cheap authenticated request to check that the
+// client's access key is valid, and returns information on its account. Call
+// it at startup to fail fast on a misconfigured key:
+//
+//	if _, err := client.VerifyCredentials(); errors.Is(err, messagebird.ErrUnauthorized) {
+//		log.Fatal("invalid MessageBird access key")
+//	}
+func (c *Client) VerifyCredentials() (*AccountInfo, error) {
+	var balance struct {
+		Payment string
+		Type    string
+		Amount  json.Number
+	}
+	if err := c.Request(&balance, http.MethodGet, "balance", nil); err != nil {
+		return nil, err
+	}
+
+	return &AccountInfo{
+		Payment: balance.Payment,
+		Type:    balance.Type,
+		Balance: balance.Amount,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package messagebird
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyCredentials(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/balance", r.URL.Path)
+		if r.Header.Get("Authorization") != "AccessKey test_gshuPaZoeEG6ovbc8M79w0QyM" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"errors":[{"code":2,"description":"Request not allowed (incorrect access_key)","parameter":"access_key"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"payment":"prepaid","type":"credits","amount":9.2}`))
+	})
+	client.BaseURL = srv.URL
+
+	info, err := client.VerifyCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, &AccountInfo{Payment: "prepaid", Type: "credits", Balance: json.Number("9.2")}, info)
+
+	client.AccessKey = "invalid"
+	_, err = client.VerifyCredentials()
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+}
@@ -1,6 +1,7 @@
 package balance
 
 import (
+	"encoding/json"
 	"net/http"
 
 	messagebird "github.com/messagebird/go-rest-api/v7"
@@ -10,14 +11,18 @@ import (
 type Balance struct {
 	Payment string
 	Type    string
-	Amount  float32
+
+	// Amount is the exact balance as returned by the API. Use its String
+	// method, or a decimal library, rather than converting it to a float when
+	// reconciling spend.
+	Amount json.Number
 }
 
 const path = "balance"
 
 // Read returns the balance information for the account that is associated with
 // the access key.
-func Read(c *messagebird.Client) (*Balance, error) {
+func Read(c messagebird.Requester) (*Balance, error) {
 	balance := &Balance{}
 	if err := c.Request(balance, http.MethodGet, path, nil); err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package balance
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -25,7 +26,7 @@ func TestRead(t *testing.T) {
 
 	assert.Equal(t, "credits", balance.Type)
 
-	assert.EqualValuesf(t, 9.2, balance.Amount, "Unexpected balance amount: %.2f", balance.Amount)
+	assert.Equal(t, json.Number("9.2"), balance.Amount)
 }
 
 func TestReadError(t *testing.T) {
@@ -44,3 +45,27 @@ func TestReadError(t *testing.T) {
 
 	assert.Equal(t, "access_key", errorResponse.Errors[0].Parameter)
 }
+
+// fakeRequester is a messagebird.Requester that returns a fixed response
+// without sending any requests.
+type fakeRequester struct {
+	response string
+	method   string
+	path     string
+}
+
+func (f *fakeRequester) Request(v interface{}, method, path string, data interface{}) error {
+	f.method, f.path = method, path
+	return json.Unmarshal([]byte(f.response), v)
+}
+
+func TestReadWithFakeRequester(t *testing.T) {
+	fake := &fakeRequester{response: `{"payment":"postpaid","type":"euros","amount":42}`}
+
+	balance, err := Read(fake)
+	assert.NoError(t, err)
+	assert.Equal(t, "postpaid", balance.Payment)
+	assert.Equal(t, json.Number("42"), balance.Amount)
+	assert.Equal(t, http.MethodGet, fake.method)
+	assert.Equal(t, "balance", fake.path)
+}
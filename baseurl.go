@@ -0,0 +1,35 @@
+package messagebird
+
+import (
+	"net/url"
+	"strings"
+)
+
+// resolveURL rewrites u if its host has a replacement base URL configured. It
+// returns u itself if nothing is to be replaced.
+func (c *Client) resolveURL(u *url.URL) *url.URL {
+	base, ok := c.APIBaseURLs[u.Host]
+	if u.Host == restHost && c.BaseURL != "" {
+		base, ok = c.BaseURL, true
+	}
+	if !ok {
+		return u
+	}
+
+	baseURL, err := url.Parse(strings.TrimSuffix(base, "/"))
+	if err != nil {
+		return u
+	}
+
+	resolved := *u
+	resolved.Scheme = baseURL.Scheme
+	resolved.Host = baseURL.Host
+	resolved.Path = baseURL.Path + u.Path
+	if u.RawPath != "" {
+		resolved.RawPath = baseURL.EscapedPath() + u.RawPath
+	}
+	if baseURL.User != nil {
+		resolved.User = baseURL.User
+	}
+	return &resolved
+}
@@ -0,0 +1,40 @@
+package messagebird
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseURL(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/proxy/balance", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	client.BaseURL = srv.URL + "/proxy/"
+
+	assert.NoError(t, client.Request(nil, http.MethodGet, "balance", nil))
+}
+
+func TestResolveURL(t *testing.T) {
+	client := &Client{
+		BaseURL: "https://rest.eu.example.com",
+		APIBaseURLs: map[string]string{
+			voiceHost: "http://localhost:8080/voice",
+		},
+	}
+
+	tests := map[string]string{
+		"https://rest.messagebird.com/verify/some-id?token=123": "https://rest.eu.example.com/verify/some-id?token=123",
+		"https://voice.messagebird.com/v1/calls":                "http://localhost:8080/voice/v1/calls",
+		"https://numbers.messagebird.com/v1/phone-numbers":      "https://numbers.messagebird.com/v1/phone-numbers",
+	}
+	for in, expected := range tests {
+		u, err := url.Parse(in)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, client.resolveURL(u).String())
+	}
+}
@@ -0,0 +1,106 @@
+package messagebird
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+)
+
+// RawBody is request data that is sent as is, with the given content type,
+// e.g. to upload a media file:
+//
+//	f, _ := os.Open("image.png")
+//	defer f.Close()
+//	err := client.Request(&v, http.MethodPost, path, &messagebird.RawBody{
+//		ContentType: "image/png",
+//		Body:        f,
+//	})
+//
+// Body is read completely before the request is sent, so it can be retried.
+type RawBody struct {
+	ContentType string
+	Body        io.Reader
+}
+
+// MultipartBody is request data that is sent as multipart/form-data.
+type MultipartBody struct {
+	Fields map[string]string // Form fields, sent before the files.
+	Files  []MultipartFile
+}
+
+// MultipartFile is a file that is part of a MultipartBody.
+type MultipartFile struct {
+	FieldName   string    // Name of the form field.
+	FileName    string    // Name of the file.
+	ContentType string    // Optional, defaults to application/octet-stream.
+	Content     io.Reader // Contents of the file.
+}
+
+// encode reads the body and returns it alongside its content type.
+func (b *RawBody) encode() ([]byte, contentType, error) {
+	if b.Body == nil {
+		return nil, contentType(b.ContentType), nil
+	}
+	data, err := ioutil.ReadAll(b.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, contentType(b.ContentType), nil
+}
+
+// encode writes the body as multipart/form-data and returns it alongside the
+// content type, including the boundary.
+func (b *MultipartBody) encode() ([]byte, contentType, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	// Sort the fields so the body is deterministic.
+	names := make([]string, 0, len(b.Fields))
+	for name := range b.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := w.WriteField(name, b.Fields[name]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, f := range b.Files {
+		ct := f.ContentType
+		if ct == "" {
+			ct = "application/octet-stream"
+		}
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escapeQuotes(f.FieldName), escapeQuotes(f.FileName)))
+		h.Set("Content-Type", ct)
+
+		part, err := w.CreatePart(h)
+		if err != nil {
+			return nil, "", err
+		}
+		if f.Content != nil {
+			if _, err := io.Copy(part, f.Content); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), contentType(w.FormDataContentType()), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes s for use in a quoted header parameter, like
+// mime/multipart does for CreateFormFile.
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
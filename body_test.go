@@ -0,0 +1,53 @@
+package messagebird
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawBody(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "image/png", r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, "\x89PNG", string(body))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"file-id"}`))
+	})
+
+	var v struct{ ID string }
+	err := client.Request(&v, http.MethodPost, srv.URL+"/files", &RawBody{
+		ContentType: "image/png",
+		Body:        strings.NewReader("\x89PNG"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "file-id", v.ID)
+}
+
+func TestMultipartBody(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "Hello", r.FormValue("subject"))
+
+		f, h, err := r.FormFile("media")
+		assert.NoError(t, err)
+		defer f.Close()
+		content, _ := ioutil.ReadAll(f)
+		assert.Equal(t, `say "cheese".jpg`, h.Filename)
+		assert.Equal(t, "image/jpeg", h.Header.Get("Content-Type"))
+		assert.Equal(t, "jpeg", string(content))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.Request(nil, http.MethodPost, srv.URL+"/files", &MultipartBody{
+		Fields: map[string]string{"subject": "Hello"},
+		Files: []MultipartFile{
+			{FieldName: "media", FileName: `say "cheese".jpg`, ContentType: "image/jpeg", Content: strings.NewReader("jpeg")},
+		},
+	})
+	assert.NoError(t, err)
+}
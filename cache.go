@@ -0,0 +1,82 @@
+package messagebird
+
+import (
+	"net/http"
+	"sync"
+)
+
+// DefaultCacheSize is the maximum number of responses a ResponseCache created
+// with a non-positive size holds.
+const DefaultCacheSize = 1000
+
+// A ResponseCache stores responses to GET requests that carry an ETag or
+// Last-Modified header. When a client with a cache repeats such a request, it
+// sends a conditional request, and the API can answer with 304 Not Modified
+// instead of the full resource. This reduces the cost of polling, e.g. for
+// the status of a message.
+//
+// Responses are cached by URL, so a cache must not be shared by clients for
+// different accounts. A ResponseCache is safe for concurrent use.
+type ResponseCache struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// NewResponseCache returns a ResponseCache holding up to size responses. When
+// the cache is full, an arbitrary response is evicted to make room.
+func NewResponseCache(size int) *ResponseCache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	return &ResponseCache{size: size, entries: make(map[string]*cacheEntry)}
+}
+
+// get returns the entry for key, or nil if there is none.
+func (rc *ResponseCache) get(key string) *cacheEntry {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.entries[key]
+}
+
+// store caches the body of response if it carries validators.
+func (rc *ResponseCache) store(key string, response *http.Response, body []byte) {
+	entry := &cacheEntry{
+		etag:         response.Header.Get("ETag"),
+		lastModified: response.Header.Get("Last-Modified"),
+		body:         body,
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if entry.etag == "" && entry.lastModified == "" {
+		delete(rc.entries, key)
+		return
+	}
+	if _, ok := rc.entries[key]; !ok && len(rc.entries) >= rc.size {
+		for k := range rc.entries {
+			delete(rc.entries, k)
+			break
+		}
+	}
+	rc.entries[key] = entry
+}
+
+// setConditionalHeaders makes header ask for the resource only if it changed
+// since entry was cached.
+func (e *cacheEntry) setConditionalHeaders(header http.Header) {
+	if e.etag != "" {
+		header.Set("If-None-Match", e.etag)
+	}
+	if e.lastModified != "" {
+		header.Set("If-Modified-Since", e.lastModified)
+	}
+}
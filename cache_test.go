@@ -0,0 +1,60 @@
+package messagebird
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	amount := 9
+	var statuses []int
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		etag := `"v9"`
+		if amount != 9 {
+			etag = `"v10"`
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			statuses = append(statuses, http.StatusNotModified)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		statuses = append(statuses, http.StatusOK)
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusOK)
+		if amount == 9 {
+			w.Write([]byte(`{"amount":9}`))
+		} else {
+			w.Write([]byte(`{"amount":10}`))
+		}
+	})
+	client.Cache = NewResponseCache(0)
+
+	for i := 0; i < 2; i++ {
+		var v struct{ Amount int }
+		assert.NoError(t, client.Request(&v, http.MethodGet, srv.URL+"/balance", nil))
+		assert.Equal(t, 9, v.Amount)
+	}
+
+	amount = 10
+	var v struct{ Amount int }
+	assert.NoError(t, client.Request(&v, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, 10, v.Amount)
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusNotModified, http.StatusOK}, statuses)
+}
+
+func TestResponseCacheEviction(t *testing.T) {
+	rc := NewResponseCache(1)
+	response := &http.Response{Header: http.Header{"Etag": []string{`"v1"`}}}
+
+	rc.store("a", response, []byte(`{}`))
+	rc.store("b", response, []byte(`{}`))
+	assert.Nil(t, rc.get("a"))
+	assert.NotNil(t, rc.get("b"))
+
+	// Responses without validators remove the cached entry.
+	rc.store("b", &http.Response{Header: http.Header{}}, []byte(`{}`))
+	assert.Nil(t, rc.get("b"))
+}
@@ -0,0 +1,25 @@
+package messagebird
+
+import "context"
+
+// Call makes a request using c and decodes the response into a value of type
+// T. It is useful for endpoints that are not (yet) covered by the resource
+// packages:
+//
+//	type File struct {
+//		ID       string
+//		Filename string
+//	}
+//
+//	file, err := messagebird.Call[File](ctx, client, http.MethodGet, "files/some-id", nil)
+//
+// path and data are as for Request. For requests that don't return content,
+// such as deletes, use struct{} as T.
+func Call[T any](ctx context.Context, c Requester, method, path string, data interface{}) (T, error) {
+	var v T
+	if err := RequestContext(ctx, c, &v, method, path, data); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
@@ -0,0 +1,59 @@
+package messagebird
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type file struct {
+	ID       string
+	Filename string
+}
+
+func TestCall(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id":"file-id","filename":"image.png"}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":20,"description":"not found","parameter":null}]}`))
+		}
+	})
+
+	f, err := Call[file](context.Background(), client, http.MethodGet, srv.URL+"/files/file-id", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, file{ID: "file-id", Filename: "image.png"}, f)
+
+	p, err := Call[*file](context.Background(), client, http.MethodGet, srv.URL+"/files/file-id", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "file-id", p.ID)
+
+	_, err = Call[struct{}](context.Background(), client, http.MethodDelete, srv.URL+"/files/file-id", nil)
+	assert.NoError(t, err)
+
+	p, err = Call[*file](context.Background(), client, http.MethodPost, srv.URL+"/files", nil)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Nil(t, p)
+}
+
+// plainRequester implements Requester, but not ContextRequester.
+type plainRequester struct{}
+
+func (plainRequester) Request(v interface{}, method, path string, data interface{}) error {
+	return json.Unmarshal([]byte(`{"id":"fake"}`), v)
+}
+
+func TestCallWithRequester(t *testing.T) {
+	f, err := Call[file](context.Background(), plainRequester{}, http.MethodGet, "files/fake", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", f.ID)
+}
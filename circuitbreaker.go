@@ -0,0 +1,183 @@
+package messagebird
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending the request when the client's
+// CircuitBreaker is open.
+var ErrCircuitOpen = errors.New("messagebird: circuit breaker is open")
+
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed means requests are sent as usual.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen means requests fail with ErrCircuitOpen.
+	CircuitOpen
+
+	// CircuitHalfOpen means a limited number of probe requests is sent to
+	// find out whether the API has recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// A CircuitBreaker makes a client fail fast while the API is unavailable.
+// After FailureThreshold consecutive requests failed with a network error or
+// a 5xx response, the breaker opens and requests fail with ErrCircuitOpen for
+// OpenDuration. It then lets HalfOpenProbes requests through: if they all
+// succeed the breaker closes, if one fails it opens again.
+//
+// A request retried according to the client's RetryPolicy counts as a single
+// failure or success. The zero value is ready to use. A CircuitBreaker is safe
+// for concurrent use and may be shared by multiple clients.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// breaker. It defaults to 5.
+	FailureThreshold int
+
+	// OpenDuration is the time the breaker stays open before letting probes
+	// through. It defaults to 30 seconds.
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of requests let through while half-open.
+	// It defaults to 1.
+	HalfOpenProbes int
+
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int       // Consecutive failures while closed.
+	openedAt  time.Time // When the breaker last opened.
+	probes    int       // Probes in flight while half-open.
+	successes int       // Successful probes while half-open.
+}
+
+// State returns the current state of the breaker.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && time.Since(b.openedAt) >= b.openDuration() {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a request may be sent. probe is true if the request
+// is one of the half-open probes.
+func (b *CircuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen {
+		if time.Since(b.openedAt) < b.openDuration() {
+			return false, ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		b.probes = 0
+		b.successes = 0
+	}
+	if b.state == CircuitHalfOpen {
+		if b.probes >= b.halfOpenProbes() {
+			return false, ErrCircuitOpen
+		}
+		b.probes++
+		return true, nil
+	}
+	return false, nil
+}
+
+// record registers the outcome of a request that was allowed.
+func (b *CircuitBreaker) record(probe, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.state == CircuitClosed && !probe:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.failureThreshold() {
+			b.open()
+		}
+	case b.state == CircuitHalfOpen && probe:
+		b.probes--
+		if failed {
+			b.open()
+			return
+		}
+		b.successes++
+		if b.successes >= b.halfOpenProbes() {
+			b.state = CircuitClosed
+			b.failures = 0
+		}
+	}
+}
+
+// release frees the probe slot of a request whose outcome says nothing about
+// the API, e.g. because it was cancelled.
+func (b *CircuitBreaker) release(probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe && b.state == CircuitHalfOpen {
+		b.probes--
+	}
+}
+
+func (b *CircuitBreaker) open() {
+	b.state = CircuitOpen
+	b.openedAt = time.Now()
+	b.failures = 0
+}
+
+func (b *CircuitBreaker) failureThreshold() int {
+	if b.FailureThreshold > 0 {
+		return b.FailureThreshold
+	}
+	return defaultFailureThreshold
+}
+
+func (b *CircuitBreaker) openDuration() time.Duration {
+	if b.OpenDuration > 0 {
+		return b.OpenDuration
+	}
+	return defaultOpenDuration
+}
+
+func (b *CircuitBreaker) halfOpenProbes() int {
+	if b.HalfOpenProbes > 0 {
+		return b.HalfOpenProbes
+	}
+	return 1
+}
+
+// isCircuitFailure reports whether the outcome of a request indicates that
+// the API is unavailable.
+func isCircuitFailure(response *http.Response, err error) bool {
+	if response != nil {
+		return response.StatusCode >= http.StatusInternalServerError
+	}
+	return err != nil
+}
@@ -0,0 +1,88 @@
+package messagebird
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var healthy int32
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	breaker := &CircuitBreaker{FailureThreshold: 2, OpenDuration: 50 * time.Millisecond}
+	client.CircuitBreaker = breaker
+
+	request := func() error {
+		return client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+	}
+
+	assert.Equal(t, ErrUnexpectedResponse, request())
+	assert.Equal(t, CircuitClosed, breaker.State())
+	assert.Equal(t, ErrUnexpectedResponse, request())
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	// Requests fail fast while open.
+	assert.Equal(t, ErrCircuitOpen, request())
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+
+	// A failed probe opens the breaker again.
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, CircuitHalfOpen, breaker.State())
+	assert.Equal(t, ErrUnexpectedResponse, request())
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	// A successful probe closes it.
+	atomic.StoreInt32(&healthy, 1)
+	time.Sleep(60 * time.Millisecond)
+	assert.NoError(t, request())
+	assert.Equal(t, CircuitClosed, breaker.State())
+	assert.NoError(t, request())
+	assert.EqualValues(t, 5, atomic.LoadInt32(&calls))
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":20,"description":"message not found","parameter":null}]}`))
+	})
+	breaker := &CircuitBreaker{FailureThreshold: 1}
+	client.CircuitBreaker = breaker
+
+	assert.Error(t, client.Request(nil, http.MethodGet, srv.URL+"/messages/id", nil))
+	assert.Equal(t, CircuitClosed, breaker.State())
+}
+
+func TestCircuitBreakerHalfOpenProbes(t *testing.T) {
+	b := &CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Millisecond, HalfOpenProbes: 2}
+
+	_, err := b.allow()
+	assert.NoError(t, err)
+	b.record(false, true)
+	assert.Equal(t, CircuitOpen, b.State())
+
+	time.Sleep(2 * time.Millisecond)
+	probe1, err := b.allow()
+	assert.True(t, probe1)
+	assert.NoError(t, err)
+	probe2, err := b.allow()
+	assert.True(t, probe2)
+	assert.NoError(t, err)
+	_, err = b.allow()
+	assert.Equal(t, ErrCircuitOpen, err)
+
+	b.record(probe1, false)
+	assert.Equal(t, CircuitHalfOpen, b.State())
+	b.record(probe2, false)
+	assert.Equal(t, CircuitClosed, b.State())
+}
@@ -11,14 +11,13 @@ package messagebird
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
-	"runtime"
 	"strings"
 	"sync"
 	"time"
@@ -34,6 +33,9 @@ const (
 	// httpClientTimeout is used to limit http.Client waiting time.
 	httpClientTimeout = 15 * time.Second
 
+	// restHost is the host name for the REST API.
+	restHost = "rest.messagebird.com"
+
 	// voiceHost is the host name for the Voice API.
 	voiceHost = "voice.messagebird.com"
 )
@@ -54,11 +56,98 @@ const (
 // Client is used to access API with a given key.
 // Uses standard lib HTTP client internally, so should be reused instead of created as needed and it is safe for concurrent use.
 type Client struct {
-	AccessKey     string           // The API access key.
-	HTTPClient    *http.Client     // The HTTP client to send requests on.
-	DebugLog      *log.Logger      // Optional logger for debugging purposes.
+	AccessKey   string              // The API access key.
+	Credentials CredentialsProvider // Optional provider of access keys, takes precedence over AccessKey.
+	HTTPClient  *http.Client        // The HTTP client to send requests on.
+	DebugLog    *log.Logger         // Optional logger for debugging purposes.
+	Logger      Logger              // Optional logger for request summaries, with secrets redacted.
+	Stats       StatsRecorder       // Optional recorder of request metrics.
+	Hooks       Hooks               // Optional callbacks for every attempt of a request.
+	RetryPolicy *RetryPolicy        // Optional policy for retrying failed requests.
+
+	// CircuitBreaker optionally makes requests fail fast while the API is
+	// unavailable.
+	CircuitBreaker *CircuitBreaker
+
+	// RateLimiter optionally limits the rate at which requests are sent.
+	RateLimiter *RateLimiter
+
+	// Cache optionally enables conditional GET requests, see ResponseCache.
+	Cache *ResponseCache
+
+	// CompressRequestsOver optionally enables gzip compression of request
+	// bodies of at least this many bytes, e.g. for bulk sends. Zero disables
+	// compression. Responses are always requested gzip compressed.
+	CompressRequestsOver int
+
+	// Header optionally holds headers to add to every request, e.g. for
+	// tracing. See WithHeader for adding headers to a single request.
+	Header http.Header
+
+	// AppInfo optionally identifies your application in the User-Agent
+	// header of requests.
+	AppInfo AppInfo
+
+	// APIVersion optionally pins the API version, sent in the
+	// MessageBird-Version header of every request, so responses keep the
+	// shape of that version. Use WithHeader to pin the version of a single
+	// request instead.
+	APIVersion string
+
+	// DryRun makes the client log mutating requests (e.g. POST and DELETE)
+	// to Logger instead of sending them, so no messages are sent and no
+	// resources are changed. Instead, a synthetic response is returned with
+	// a random ID and the fields of the request. Other requests are sent as
+	// usual.
+	DryRun bool
+
+	// TestMode tells the client that it uses a test access key, see
+	// IsTestAccessKey. The API doesn't store the objects it returns for test
+	// keys, so reading or deleting them by ID results in a 404. In test mode,
+	// such 404s are not treated as errors for objects the client created
+	// before: deletes succeed, and reads return a stub that only has its ID
+	// set. 404s for other paths, e.g. IDs that were never created, are still
+	// returned as errors. Responses are marked in ResponseMetadata.TestMode.
+	//
+	// The IDs of created objects are only tracked by clients created with New
+	// or NewWithHTTPClient, and shared with their copies.
+	TestMode bool
+
+	// CurlOnError makes failed requests return a *CurlError, which holds a
+	// curl command that reproduces the request, with secrets redacted. This
+	// is useful when escalating issues to support. Note that the returned
+	// errors must then be inspected using errors.Is and errors.As rather than
+	// type assertions.
+	CurlOnError bool
+
+	// StrictDecoding makes decoding a response fail when it contains fields
+	// that the type it is decoded into doesn't have. This helps detecting
+	// changes to the API in tests. Types that implement json.Unmarshaler,
+	// like verify.Verify and the voice types, are only checked as far as
+	// their UnmarshalJSON method does.
+	StrictDecoding bool
+
+	// BaseURL optionally replaces Endpoint as the base URL of the REST API,
+	// e.g. to point the client to a proxy.
+	BaseURL string
+
+	// APIBaseURLs optionally replaces the base URLs of the other APIs, e.g.
+	// Voice and Conversations. Keys are the hosts that are replaced (e.g.
+	// "voice.messagebird.com"), values the new base URLs.
+	APIBaseURLs map[string]string
+
 	features      map[Feature]bool // Enabled features.
 	featuresMutex sync.RWMutex     // Mutex for accessing feature map.
+
+	// ctx is the context requests made through Request are bound to. It is
+	// set by WithContext; a nil ctx means context.Background().
+	ctx context.Context
+
+	// middleware wraps the HTTP client when sending requests, see Use.
+	middleware []Middleware
+
+	// testModeIDs holds the IDs of the objects created in TestMode.
+	testModeIDs *testModeIDs
 }
 
 type contentType string
@@ -74,6 +163,11 @@ type errorReader func([]byte) error
 
 var voiceErrorReader errorReader
 
+// defaultHTTPClient is used by clients that have no HTTPClient set.
+var defaultHTTPClient = &http.Client{
+	Timeout: httpClientTimeout,
+}
+
 // New creates a new MessageBird client object.
 func New(accessKey string) *Client {
 	return &Client{
@@ -81,8 +175,39 @@ func New(accessKey string) *Client {
 		HTTPClient: &http.Client{
 			Timeout: httpClientTimeout,
 		},
-		features: make(map[Feature]bool),
+		features:    make(map[Feature]bool),
+		testModeIDs: &testModeIDs{},
+	}
+}
+
+// NewWithHTTPClient creates a new MessageBird client object that sends its
+// requests using httpClient. This can be used to route all API traffic through
+// a custom transport, e.g. for tracing or proxying.
+func NewWithHTTPClient(accessKey string, httpClient *http.Client) *Client {
+	c := New(accessKey)
+	c.HTTPClient = httpClient
+	return c
+}
+
+// SetTransport sets the http.RoundTripper used to send requests. The client's
+// HTTPClient is copied rather than modified, so it is safe to call this on a
+// client that was created with a shared http.Client.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	httpClient := http.Client{Timeout: httpClientTimeout}
+	if c.HTTPClient != nil {
+		httpClient = *c.HTTPClient
+	}
+	httpClient.Transport = rt
+	c.HTTPClient = &httpClient
+}
+
+// httpClient returns the client's HTTPClient, or a default one if it is not
+// set.
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
 	}
+	return defaultHTTPClient
 }
 
 // SetVoiceErrorReader takes an errorReader that must parse raw JSON errors
@@ -91,6 +216,71 @@ func SetVoiceErrorReader(r errorReader) {
 	voiceErrorReader = r
 }
 
+// WithContext returns a shallow copy of c whose requests are bound to ctx.
+// This allows cancellation and deadlines to be propagated through all resource
+// packages without changing their signatures, e.g.:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+//	defer cancel()
+//	v, err := verify.Read(client.WithContext(ctx), id)
+//
+// The provided ctx must be non-nil.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c2 := c.clone()
+	c2.ctx = ctx
+	return c2
+}
+
+// Context returns the client's context. The returned context is always
+// non-nil; it defaults to the background context.
+func (c *Client) Context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
+// clone returns a copy of c. Enabled features are copied rather than shared.
+func (c *Client) clone() *Client {
+	c.featuresMutex.RLock()
+	features := make(map[Feature]bool, len(c.features))
+	for f, enabled := range c.features {
+		features[f] = enabled
+	}
+	c.featuresMutex.RUnlock()
+
+	return &Client{
+		AccessKey:            c.AccessKey,
+		Credentials:          c.Credentials,
+		HTTPClient:           c.HTTPClient,
+		DebugLog:             c.DebugLog,
+		Logger:               c.Logger,
+		Stats:                c.Stats,
+		Hooks:                c.Hooks,
+		RetryPolicy:          c.RetryPolicy,
+		CircuitBreaker:       c.CircuitBreaker,
+		RateLimiter:          c.RateLimiter,
+		Cache:                c.Cache,
+		CompressRequestsOver: c.CompressRequestsOver,
+		Header:               c.Header,
+		AppInfo:              c.AppInfo,
+		APIVersion:           c.APIVersion,
+		DryRun:               c.DryRun,
+		TestMode:             c.TestMode,
+		CurlOnError:          c.CurlOnError,
+		StrictDecoding:       c.StrictDecoding,
+		BaseURL:              c.BaseURL,
+		APIBaseURLs:          c.APIBaseURLs,
+		features:             features,
+		ctx:                  c.ctx,
+		middleware:           c.middleware,
+		testModeIDs:          c.testModeIDs,
+	}
+}
+
 // EnableFeatures enables a feature.
 func (c *Client) EnableFeatures(feature Feature) {
 	c.featuresMutex.Lock()
@@ -117,10 +307,13 @@ func (c *Client) IsFeatureEnabled(feature Feature) bool {
 
 // Request is for internal use only and unstable.
 func (c *Client) Request(v interface{}, method, path string, data interface{}) error {
-	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
-		path = fmt.Sprintf("%s/%s", Endpoint, path)
-	}
-	uri, err := url.Parse(path)
+	return c.RequestContext(c.Context(), v, method, path, data)
+}
+
+// RequestContext is like Request, but the request is bound to ctx instead of
+// the client's context. It is for internal use only and unstable.
+func (c *Client) RequestContext(ctx context.Context, v interface{}, method, path string, data interface{}) error {
+	uri, err := requestURL(path)
 	if err != nil {
 		return err
 	}
@@ -130,48 +323,232 @@ func (c *Client) Request(v interface{}, method, path string, data interface{}) e
 		return err
 	}
 
-	request, err := http.NewRequest(method, uri.String(), bytes.NewBuffer(body))
+	if c.DryRun && isMutating(method) {
+		return c.dryRun(v, method, uri, body)
+	}
+
+	header, err := c.requestHeader(ctx, method, contentType)
 	if err != nil {
 		return err
 	}
 
-	request.Header.Set("Accept", "application/json")
-	request.Header.Set("Authorization", "AccessKey "+c.AccessKey)
-	request.Header.Set("User-Agent", "MessageBird/ApiClient/"+ClientVersion+" Go/"+runtime.Version())
+	sendBody, compressed, err := c.compressBody(body)
+	if err != nil {
+		return err
+	}
+	if compressed {
+		header.Set("Content-Encoding", gzipEncoding)
+	}
+
+	var cached *cacheEntry
+	if c.Cache != nil && method == http.MethodGet {
+		if cached = c.Cache.get(uri.String()); cached != nil {
+			cached.setConditionalHeaders(header)
+		}
+	}
+
+	if d := timeoutFromContext(ctx); d > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+
+	var probe bool
+	if c.CircuitBreaker != nil {
+		if probe, err = c.CircuitBreaker.allow(); err != nil {
+			return err
+		}
+	}
+
+	start := time.Now()
+	response, responseBody, attempts, err := c.sendWithRetries(ctx, method, uri, header, sendBody)
+	if c.CircuitBreaker != nil {
+		if ctx.Err() != nil {
+			c.CircuitBreaker.release(probe)
+		} else {
+			c.CircuitBreaker.record(probe, isCircuitFailure(response, err))
+		}
+	}
+	setResponseMetadata(ctx, response, c.TestMode)
+	if err == nil && cached != nil && response.StatusCode == http.StatusNotModified {
+		err = decodeResponse(v, uri, &http.Response{StatusCode: http.StatusOK, Header: response.Header}, cached.body, c.StrictDecoding)
+	} else if err == nil && c.isTestModeNotFound(method, uri, response) {
+		err = decodeTestModeObject(v, method, uri)
+	} else if err == nil {
+		err = decodeResponse(v, uri, response, responseBody, c.StrictDecoding)
+		if err == nil && c.TestMode && method == http.MethodPost {
+			c.testModeIDs.add(responseBody)
+		}
+		if err == nil && c.Cache != nil && method == http.MethodGet {
+			c.Cache.store(uri.String(), response, responseBody)
+		}
+	}
+
+	if c.Stats != nil {
+		stats := RequestStats{
+			Method:   method,
+			Host:     uri.Host,
+			Path:     uri.Path,
+			Duration: time.Since(start),
+			Attempts: attempts,
+			Err:      err,
+		}
+		if response != nil {
+			stats.StatusCode = response.StatusCode
+		}
+		c.Stats.RecordRequest(stats)
+	}
+
+	if err != nil && c.CurlOnError {
+		err = &CurlError{Err: err, Command: c.curlCommand(ctx, method, uri, header, body)}
+	}
+
+	return err
+}
+
+// requestURL parses path, which is either absolute or relative to Endpoint.
+func requestURL(path string) (*url.URL, error) {
+	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
+		path = fmt.Sprintf("%s/%s", Endpoint, path)
+	}
+	return url.Parse(path)
+}
+
+// sendWithRetries sends a request, retrying as configured by the client's
+// RetryPolicy. It returns the last response and the number of attempts made.
+func (c *Client) sendWithRetries(ctx context.Context, method string, uri *url.URL, header http.Header, body []byte) (*http.Response, []byte, int, error) {
+	for attempt := 1; ; attempt++ {
+		response, responseBody, err := c.sendAttempt(ctx, method, uri, header, body, attempt)
+		delay, retry := c.retryDelay(ctx, attempt, response, err)
+		if !retry {
+			return response, responseBody, attempt, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			// The next attempt could not finish in time anyway.
+			return response, responseBody, attempt, err
+		}
+		if err := Sleep(ctx, delay); err != nil {
+			return response, responseBody, attempt, err
+		}
+	}
+}
+
+// sendAttempt calls send, limiting its duration to the AttemptTimeout of the
+// client's RetryPolicy. It waits for the client's RateLimiter first, and calls
+// the client's Hooks.
+func (c *Client) sendAttempt(ctx context.Context, method string, uri *url.URL, header http.Header, body []byte, attempt int) (*http.Response, []byte, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	if c.RetryPolicy != nil && c.RetryPolicy.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RetryPolicy.AttemptTimeout)
+		defer cancel()
+	}
+
+	info := RequestInfo{Method: method, Host: uri.Host, Path: uri.Path, Attempt: attempt}
+	if c.Hooks.OnRequest != nil {
+		c.Hooks.OnRequest(info)
+	}
+
+	start := time.Now()
+	response, responseBody, err := c.send(ctx, method, uri, header, body)
+	if err != nil {
+		if c.Hooks.OnError != nil {
+			c.Hooks.OnError(info, err)
+		}
+	} else if c.Hooks.OnResponse != nil {
+		c.Hooks.OnResponse(ResponseInfo{RequestInfo: info, StatusCode: response.StatusCode, Duration: time.Since(start)})
+	}
+
+	return response, responseBody, err
+}
+
+// requestHeader returns the headers to send with every attempt of a request.
+func (c *Client) requestHeader(ctx context.Context, method string, contentType contentType) (http.Header, error) {
+	header := http.Header{}
+	header.Set("Accept", "application/json")
+	// Setting Accept-Encoding disables the transparent decompression of
+	// http.Transport, so responses are decompressed by send. This makes
+	// compression work regardless of the transport in use.
+	header.Set("Accept-Encoding", gzipEncoding)
 	if contentType != contentTypeEmpty {
-		request.Header.Set("Content-Type", string(contentType))
+		header.Set("Content-Type", string(contentType))
+	}
+
+	key := idempotencyKeyFromContext(ctx)
+	if key == "" && method == http.MethodPost && c.RetryPolicy != nil && c.RetryPolicy.MaxAttempts > 1 {
+		// Retried creates must not be processed twice, so each request gets
+		// exactly one key that is reused for all of its attempts.
+		var err error
+		if key, err = newIdempotencyKey(); err != nil {
+			return nil, err
+		}
+	}
+	if key != "" {
+		header.Set(IdempotencyKeyHeader, key)
+	}
+
+	return header, nil
+}
+
+// send performs a single attempt of a request. The response body is read and
+// closed, and returned separately.
+func (c *Client) send(ctx context.Context, method string, uri *url.URL, header http.Header, body []byte) (*http.Response, []byte, error) {
+	request, err := http.NewRequestWithContext(ctx, method, uri.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
 	}
+	request.Header = header.Clone()
 
 	if c.DebugLog != nil {
-		if data != nil {
-			c.DebugLog.Printf("HTTP REQUEST: %s %s %s", method, uri.String(), body)
+		ct := contentType(header.Get("Content-Type"))
+		if header.Get("Content-Encoding") == gzipEncoding {
+			c.DebugLog.Printf("HTTP REQUEST: %s %s [%d bytes gzip compressed]", method, redactURL(uri), len(body))
+		} else if body != nil && ct != contentTypeJSON && ct != contentTypeFormURLEncoded {
+			c.DebugLog.Printf("HTTP REQUEST: %s %s [%d bytes %s]", method, redactURL(uri), len(body), ct)
+		} else if body != nil {
+			c.DebugLog.Printf("HTTP REQUEST: %s %s %s", method, redactURL(uri), redactBody(body))
 		} else {
-			c.DebugLog.Printf("HTTP REQUEST: %s %s", method, uri.String())
+			c.DebugLog.Printf("HTTP REQUEST: %s %s", method, redactURL(uri))
 		}
 	}
 
-	response, err := c.HTTPClient.Do(request)
+	response, err := c.Do(request)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	defer response.Body.Close()
 
-	responseBody, err := ioutil.ReadAll(response.Body)
+	responseBody, err := readResponseBody(response)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if c.DebugLog != nil {
 		c.DebugLog.Printf("HTTP RESPONSE: %s", string(responseBody))
 	}
 
+	return response, responseBody, nil
+}
+
+// decodeResponse unmarshals responseBody into v, or into the appropriate
+// error, based on the status code. If strict is true, unknown fields in a
+// successful response are an error.
+func decodeResponse(v interface{}, uri *url.URL, response *http.Response, responseBody []byte, strict bool) error {
 	switch response.StatusCode {
 	case http.StatusOK, http.StatusCreated:
 		// Status codes 200 and 201 are indicative of being able to convert the
 		// response body to the struct that was specified.
-		if err := json.Unmarshal(responseBody, &v); err != nil {
-			return fmt.Errorf("could not decode response JSON, %s: %v", string(responseBody), err)
+		dec := json.NewDecoder(bytes.NewReader(responseBody))
+		if strict {
+			dec.DisallowUnknownFields()
+		}
+		if err := dec.Decode(&v); err != nil {
+			return newResponseDecodeError(response.StatusCode, responseBody, err)
 		}
 
 		return nil
@@ -185,17 +562,32 @@ func (c *Client) Request(v interface{}, method, path string, data interface{}) e
 		return ErrUnexpectedResponse
 	default:
 		// Anything else than a 200/201/204/500 should be a JSON error.
-		if uri.Host == voiceHost && voiceErrorReader != nil {
-			return voiceErrorReader(responseBody)
+		err := decodeErrorResponse(uri, response.StatusCode, responseBody)
+		if response.StatusCode == http.StatusTooManyRequests {
+			return newRateLimitError(response.Header, err)
 		}
 
-		var errorResponse ErrorResponse
-		if err := json.Unmarshal(responseBody, &errorResponse); err != nil {
-			return err
-		}
+		return err
+	}
+}
 
-		return errorResponse
+// decodeErrorResponse parses the JSON error in responseBody. The Voice API
+// uses a different format from the other APIs, which is handled by the
+// voiceErrorReader.
+func decodeErrorResponse(uri *url.URL, statusCode int, responseBody []byte) error {
+	if uri != nil && uri.Host == voiceHost && voiceErrorReader != nil {
+		return voiceErrorReader(responseBody)
 	}
+
+	errorResponse := ErrorResponse{StatusCode: statusCode}
+	if err := json.Unmarshal(responseBody, &errorResponse); err != nil {
+		return newResponseDecodeError(statusCode, responseBody, err)
+	}
+	if len(errorResponse.Errors) == 0 {
+		return newResponseDecodeError(statusCode, responseBody, errors.New("no errors in error response"))
+	}
+
+	return errorResponse
 }
 
 // prepareRequestBody takes untyped data and attempts constructing a meaningful
@@ -207,6 +599,14 @@ func prepareRequestBody(data interface{}) ([]byte, contentType, error) {
 		return nil, contentTypeEmpty, nil
 	case string:
 		return []byte(data), contentTypeFormURLEncoded, nil
+	case *RawBody:
+		return data.encode()
+	case RawBody:
+		return data.encode()
+	case *MultipartBody:
+		return data.encode()
+	case MultipartBody:
+		return data.encode()
 	default:
 		b, err := json.Marshal(data)
 		if err != nil {
@@ -0,0 +1,108 @@
+package messagebird
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testServer starts a plain HTTP server that uses handler to respond to
+// requests. The returned client has no special configuration: use the
+// server's URL as path prefix to have requests go to the test server.
+func testServer(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return New("test_gshuPaZoeEG6ovbc8M79w0QyM"), srv
+}
+
+func TestRequestContextCanceled(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := client.RequestContext(ctx, nil, http.MethodGet, srv.URL+"/balance", nil)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithContext(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	client.EnableFeatures(FeatureConversationsAPIWhatsAppSandbox)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bound := client.WithContext(ctx)
+
+	assert.Equal(t, ctx, bound.Context())
+	assert.Equal(t, context.Background(), client.Context())
+	assert.True(t, bound.IsFeatureEnabled(FeatureConversationsAPIWhatsAppSandbox))
+
+	var v struct{}
+	assert.NoError(t, bound.Request(&v, http.MethodGet, srv.URL+"/balance", nil))
+
+	cancel()
+	assert.ErrorIs(t, bound.Request(&v, http.MethodGet, srv.URL+"/balance", nil), context.Canceled)
+	assert.NoError(t, client.Request(&v, http.MethodGet, srv.URL+"/balance", nil))
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestSetTransport(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "yes", r.Header.Get("X-Custom-Transport"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	shared := &http.Client{}
+	client.HTTPClient = shared
+	client.SetTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		r.Header.Set("X-Custom-Transport", "yes")
+		return http.DefaultTransport.RoundTrip(r)
+	}))
+
+	assert.Nil(t, shared.Transport)
+	assert.NoError(t, client.Request(nil, http.MethodDelete, srv.URL+"/verify/some-id", nil))
+}
+
+func TestNewWithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+	client := NewWithHTTPClient("test_gshuPaZoeEG6ovbc8M79w0QyM", httpClient)
+
+	assert.Equal(t, httpClient, client.HTTPClient)
+	assert.Equal(t, defaultHTTPClient, (&Client{}).httpClient())
+}
+
+func TestStrictDecoding(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"amount":9,"statusDetails":"new field"}`))
+	})
+
+	var v struct{ Amount int }
+	assert.NoError(t, client.Request(&v, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, 9, v.Amount)
+
+	client.StrictDecoding = true
+	err := client.Request(&v, http.MethodGet, srv.URL+"/balance", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "statusDetails")
+}
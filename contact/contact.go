@@ -68,7 +68,7 @@ var DefaultListOptions = &ListOptions{
 	Offset: 0,
 }
 
-func Create(c *messagebird.Client, contactRequest *Request) (*Contact, error) {
+func Create(c messagebird.Requester, contactRequest *Request) (*Contact, error) {
 	if err := validateCreate(contactRequest); err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func validateCreate(contactRequest *Request) error {
 
 // Delete attempts deleting the contact with the provided ID. If nil is returned,
 // the resource was deleted successfully.
-func Delete(c *messagebird.Client, id string) error {
+func Delete(c messagebird.Requester, id string) error {
 	if id == "" {
 		return errors.New("id is required")
 	}
@@ -101,7 +101,7 @@ func Delete(c *messagebird.Client, id string) error {
 
 // List retrieves a paginated list of contacts, based on the options provided.
 // It's worth noting DefaultListOptions.
-func List(c *messagebird.Client, options *ListOptions) (*ContactList, error) {
+func List(c messagebird.Requester, options *ListOptions) (*ContactList, error) {
 	query, err := listQuery(options)
 	if err != nil {
 		return nil, err
@@ -133,7 +133,7 @@ func listQuery(options *ListOptions) (string, error) {
 }
 
 // Read retrieves the information of an existing contact.
-func Read(c *messagebird.Client, id string) (*Contact, error) {
+func Read(c messagebird.Requester, id string) (*Contact, error) {
 	contact := &Contact{}
 	if err := c.Request(contact, http.MethodGet, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -144,7 +144,7 @@ func Read(c *messagebird.Client, id string) (*Contact, error) {
 
 // Update updates the record referenced by id with any values set in contactRequest.
 // Do not set any values that should not be updated.
-func Update(c *messagebird.Client, id string, contactRequest *Request) (*Contact, error) {
+func Update(c messagebird.Requester, id string, contactRequest *Request) (*Contact, error) {
 	contact := &Contact{}
 	if err := c.Request(contact, http.MethodPatch, path+"/"+id, contactRequest); err != nil {
 		return nil, err
@@ -0,0 +1,155 @@
+package messagebird
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+)
+
+// contextKey is the type of keys for values that the client reads from a
+// request's context.
+type contextKey int
+
+const (
+	idempotencyKeyContextKey contextKey = iota
+	responseMetadataContextKey
+	timeoutContextKey
+	headerContextKey
+	originalURLContextKey
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the idempotency key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// APIVersionHeader is the request header carrying the API version set
+	// through Client.APIVersion.
+	APIVersionHeader = "MessageBird-Version"
+
+	// RequestIDHeader is the response header carrying the ID MessageBird
+	// assigned to a request. Include it in support tickets.
+	RequestIDHeader = "X-MessageBird-Request-Id"
+)
+
+// ResponseMetadata holds information from an API response that is not part of
+// the returned resource.
+type ResponseMetadata struct {
+	RequestID  string      // Value of the X-MessageBird-Request-Id header.
+	StatusCode int         // HTTP status code of the response.
+	RateLimit  RateLimit   // Rate limit state after the request.
+	Header     http.Header // All response headers.
+
+	// RetryAfter is the time to wait before sending a new request, as
+	// indicated by the Retry-After header. It is zero if the header is absent.
+	RetryAfter time.Duration
+
+	// TestMode is true if the response was received by a client in
+	// TestMode, and therefore holds a stubbed object.
+	TestMode bool
+}
+
+// WithIdempotencyKey returns a copy of ctx that makes requests carry the given
+// idempotency key. The API processes requests with the same key only once, so
+// a create can safely be retried.
+//
+// When a RetryPolicy is set, POST requests without a key are given a random
+// one automatically, which is reused for all attempts of that request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey, key)
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey).(string)
+	return key
+}
+
+// newIdempotencyKey returns a random 128-bit hex encoded key.
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// WithResponseMetadata returns a copy of ctx that makes the client store the
+// metadata of the response to a request in md. When a request is retried, md
+// describes the last response. md is left untouched if no response was
+// received.
+//
+//	var md messagebird.ResponseMetadata
+//	ctx := messagebird.WithResponseMetadata(context.Background(), &md)
+//	v, err := verify.Create(client.WithContext(ctx), "31612345678", nil)
+//	log.Println("request ID:", md.RequestID)
+func WithResponseMetadata(ctx context.Context, md *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataContextKey, md)
+}
+
+// ResponseMetadataFromContext returns the ResponseMetadata registered with ctx
+// through WithResponseMetadata, or nil if there is none.
+func ResponseMetadataFromContext(ctx context.Context) *ResponseMetadata {
+	md, _ := ctx.Value(responseMetadataContextKey).(*ResponseMetadata)
+	return md
+}
+
+// setResponseMetadata stores the metadata of response in the
+// ResponseMetadata registered with ctx, if any. testMode tells whether the
+// response was received by a client in test mode.
+func setResponseMetadata(ctx context.Context, response *http.Response, testMode bool) {
+	md := ResponseMetadataFromContext(ctx)
+	if md == nil || response == nil {
+		return
+	}
+
+	*md = ResponseMetadata{
+		RequestID:  response.Header.Get(RequestIDHeader),
+		StatusCode: response.StatusCode,
+		RateLimit:  parseRateLimit(response.Header),
+		Header:     response.Header,
+		RetryAfter: parseRetryAfter(response.Header, time.Now()),
+		TestMode:   testMode,
+	}
+}
+
+// WithTimeout returns a copy of ctx that makes requests fail when they take
+// longer than d, retries included. Unlike context.WithTimeout, the deadline is
+// set when a request starts rather than when WithTimeout is called, so the
+// returned context can be used for any number of requests:
+//
+//	ctx := messagebird.WithTimeout(context.Background(), 2*time.Second)
+//	v, err := verify.VerifyToken(client.WithContext(ctx), id, token)
+//	if errors.Is(err, context.DeadlineExceeded) {
+//		// Fall back.
+//	}
+//
+// Retries are not attempted when the backoff would exceed the deadline; the
+// error of the last attempt is returned instead.
+func WithTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutContextKey, d)
+}
+
+func timeoutFromContext(ctx context.Context) time.Duration {
+	d, _ := ctx.Value(timeoutContextKey).(time.Duration)
+	return d
+}
+
+// WithHeader returns a copy of ctx that makes requests carry a header with the
+// given key and value, in addition to any headers set by previous calls to
+// WithHeader and the client's Header. Headers set this way take precedence
+// over the client's Header, but don't replace the headers the client sets
+// itself, such as Authorization and Content-Type.
+func WithHeader(ctx context.Context, key, value string) context.Context {
+	header := headerFromContext(ctx).Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(key, value)
+	return context.WithValue(ctx, headerContextKey, header)
+}
+
+func headerFromContext(ctx context.Context) http.Header {
+	header, _ := ctx.Value(headerContextKey).(http.Header)
+	return header
+}
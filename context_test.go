@@ -0,0 +1,151 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-key", r.Header.Get(IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	ctx := WithIdempotencyKey(context.Background(), "my-key")
+	assert.NoError(t, client.RequestContext(ctx, nil, http.MethodPost, srv.URL+"/verify", map[string]string{}))
+}
+
+func TestIdempotencyKeyGeneratedForRetries(t *testing.T) {
+	var mu sync.Mutex
+	var keys []string
+	var calls int
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		calls++
+		calls := calls
+		mu.Unlock()
+
+		// Fail the first attempt of the second request.
+		if calls == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	// Without retries, no key is added.
+	assert.NoError(t, client.Request(nil, http.MethodPost, srv.URL+"/verify", map[string]string{}))
+	assert.Equal(t, []string{""}, keys)
+
+	keys = nil
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.MinBackoff = time.Millisecond
+
+	assert.NoError(t, client.Request(nil, http.MethodPost, srv.URL+"/verify", map[string]string{}))
+	assert.Len(t, keys, 2)
+	assert.Len(t, keys[0], 32)
+	assert.Equal(t, keys[0], keys[1])
+}
+
+func TestWithResponseMetadata(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "4f1f8b70-5c1a-4e8f-9b5b-21c1b2a1d0e3")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{}`))
+	})
+
+	var md ResponseMetadata
+	ctx := WithResponseMetadata(context.Background(), &md)
+	assert.Same(t, &md, ResponseMetadataFromContext(ctx))
+	assert.NoError(t, client.WithContext(ctx).Request(nil, http.MethodPost, srv.URL+"/verify", map[string]string{}))
+
+	assert.Equal(t, "4f1f8b70-5c1a-4e8f-9b5b-21c1b2a1d0e3", md.RequestID)
+	assert.Equal(t, http.StatusCreated, md.StatusCode)
+	assert.Equal(t, 100, md.RateLimit.Limit)
+	assert.Equal(t, 42, md.RateLimit.Remaining)
+	assert.Equal(t, "42", md.Header.Get("X-RateLimit-Remaining"))
+	assert.Equal(t, 30*time.Second, md.RetryAfter)
+}
+
+func TestWithTimeout(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	})
+
+	ctx := WithTimeout(context.Background(), 20*time.Millisecond)
+
+	// The deadline starts with every request, so ctx can be reused.
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		err := client.RequestContext(ctx, nil, http.MethodGet, srv.URL+"/verify/id", nil)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Less(t, int64(time.Since(start)), int64(500*time.Millisecond))
+	}
+}
+
+func TestWithTimeoutSkipsRetries(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.MinBackoff = time.Second
+
+	ctx := WithTimeout(context.Background(), 100*time.Millisecond)
+	err := client.RequestContext(ctx, nil, http.MethodGet, srv.URL+"/verify/id", nil)
+	assert.Equal(t, ErrUnexpectedResponse, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestWithHeader(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-2", r.Header.Get("X-Tenant"))
+		assert.Equal(t, "trace-1", r.Header.Get("X-Trace-Id"))
+		assert.Equal(t, "gateway", r.Header.Get("X-Egress"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client.Header = http.Header{}
+	client.Header.Set("X-Tenant", "tenant-1")
+	client.Header.Set("X-Egress", "gateway")
+	client.Header.Set("Accept", "text/plain")
+
+	ctx := WithHeader(context.Background(), "X-Tenant", "tenant-2")
+	ctx = WithHeader(ctx, "X-Trace-Id", "trace-1")
+	assert.NoError(t, client.RequestContext(ctx, nil, http.MethodGet, srv.URL+"/balance", nil))
+}
+
+func TestAPIVersion(t *testing.T) {
+	var versions []string
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		versions = append(versions, r.Header.Get(APIVersionHeader))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/verify/id", nil))
+
+	client.APIVersion = "2021-06-01"
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/verify/id", nil))
+
+	ctx := WithHeader(context.Background(), APIVersionHeader, "2022-01-01")
+	assert.NoError(t, client.RequestContext(ctx, nil, http.MethodGet, srv.URL+"/verify/id", nil))
+
+	assert.Equal(t, []string{"", "2021-06-01", "2022-01-01"}, versions)
+}
@@ -216,12 +216,18 @@ const (
 	WebhookStatusDisabled WebhookStatus = "disabled"
 )
 
+// featureChecker is implemented by requesters that support optional features,
+// such as *messagebird.Client.
+type featureChecker interface {
+	IsFeatureEnabled(feature messagebird.Feature) bool
+}
+
 // request does the exact same thing as Client.Request. It does, however,
 // prefix the path with the Conversation API's root. This ensures the client
 // doesn't "handle" this for us: by default, it uses the REST API.
-func request(c *messagebird.Client, v interface{}, method, path string, data interface{}) error {
+func request(c messagebird.Requester, v interface{}, method, path string, data interface{}) error {
 	var root string
-	if c.IsFeatureEnabled(messagebird.FeatureConversationsAPIWhatsAppSandbox) {
+	if fc, ok := c.(featureChecker); ok && fc.IsFeatureEnabled(messagebird.FeatureConversationsAPIWhatsAppSandbox) {
 		root = whatsappSandboxAPIRoot
 	} else {
 		root = apiRoot
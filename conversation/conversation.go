@@ -29,7 +29,7 @@ type UpdateRequest struct {
 var DefaultListOptions = &ListOptions{10, 0}
 
 // List gets a collection of Conversations. Pagination can be set in options.
-func List(c *messagebird.Client, options *ListOptions) (*ConversationList, error) {
+func List(c messagebird.Requester, options *ListOptions) (*ConversationList, error) {
 	query := paginationQuery(options)
 
 	convList := &ConversationList{}
@@ -41,7 +41,7 @@ func List(c *messagebird.Client, options *ListOptions) (*ConversationList, error
 }
 
 // Read fetches a single Conversation based on its ID.
-func Read(c *messagebird.Client, id string) (*Conversation, error) {
+func Read(c messagebird.Requester, id string) (*Conversation, error) {
 	conv := &Conversation{}
 	if err := request(c, conv, http.MethodGet, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -52,7 +52,7 @@ func Read(c *messagebird.Client, id string) (*Conversation, error) {
 
 // Start creates a conversation by sending an initial message. If an active
 // conversation exists for the recipient, it is resumed.
-func Start(c *messagebird.Client, req *StartRequest) (*Conversation, error) {
+func Start(c messagebird.Requester, req *StartRequest) (*Conversation, error) {
 	conv := &Conversation{}
 	if err := request(c, conv, http.MethodPost, path+"/start", req); err != nil {
 		return nil, err
@@ -63,7 +63,7 @@ func Start(c *messagebird.Client, req *StartRequest) (*Conversation, error) {
 
 // Update changes the conversation's status, so this can be used to (un)archive
 // conversations.
-func Update(c *messagebird.Client, id string, req *UpdateRequest) (*Conversation, error) {
+func Update(c messagebird.Requester, id string, req *UpdateRequest) (*Conversation, error) {
 	conv := &Conversation{}
 	if err := request(c, conv, http.MethodPatch, path+"/"+id, req); err != nil {
 		return nil, err
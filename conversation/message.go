@@ -15,7 +15,7 @@ type MessageCreateRequest struct {
 
 // CreateMessage sends a new message to the specified conversation. To create a
 // new conversation and send an initial message, use conversation.Start().
-func CreateMessage(c *messagebird.Client, conversationID string, req *MessageCreateRequest) (*Message, error) {
+func CreateMessage(c messagebird.Requester, conversationID string, req *MessageCreateRequest) (*Message, error) {
 	uri := fmt.Sprintf("%s/%s/%s", path, conversationID, messagesPath)
 
 	message := &Message{}
@@ -28,7 +28,7 @@ func CreateMessage(c *messagebird.Client, conversationID string, req *MessageCre
 
 // ListMessages gets a collection of messages from a conversation. Pagination
 // can be set in the options.
-func ListMessages(c *messagebird.Client, conversationID string, options *ListOptions) (*MessageList, error) {
+func ListMessages(c messagebird.Requester, conversationID string, options *ListOptions) (*MessageList, error) {
 	query := paginationQuery(options)
 	uri := fmt.Sprintf("%s/%s/%s?%s", path, conversationID, messagesPath, query)
 
@@ -41,7 +41,7 @@ func ListMessages(c *messagebird.Client, conversationID string, options *ListOpt
 }
 
 // ReadMessage gets a single message based on its ID.
-func ReadMessage(c *messagebird.Client, messageID string) (*Message, error) {
+func ReadMessage(c messagebird.Requester, messageID string) (*Message, error) {
 	message := &Message{}
 	if err := request(c, message, http.MethodGet, messagesPath+"/"+messageID, nil); err != nil {
 		return nil, err
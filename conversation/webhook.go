@@ -20,7 +20,7 @@ type WebhookUpdateRequest struct {
 
 // CreateWebhook registers a webhook that is invoked when something interesting
 // happens.
-func CreateWebhook(c *messagebird.Client, req *WebhookCreateRequest) (*Webhook, error) {
+func CreateWebhook(c messagebird.Requester, req *WebhookCreateRequest) (*Webhook, error) {
 	webhook := &Webhook{}
 	if err := request(c, webhook, http.MethodPost, webhooksPath, req); err != nil {
 		return nil, err
@@ -31,12 +31,12 @@ func CreateWebhook(c *messagebird.Client, req *WebhookCreateRequest) (*Webhook,
 
 // DeleteWebhook ensures an existing webhook is deleted and no longer
 // triggered. If the error is nil, the deletion was successful.
-func DeleteWebhook(c *messagebird.Client, id string) error {
+func DeleteWebhook(c messagebird.Requester, id string) error {
 	return request(c, nil, http.MethodDelete, webhooksPath+"/"+id, nil)
 }
 
 // ListWebhooks gets a collection of webhooks. Pagination can be set in options.
-func ListWebhooks(c *messagebird.Client, options *ListOptions) (*WebhookList, error) {
+func ListWebhooks(c messagebird.Requester, options *ListOptions) (*WebhookList, error) {
 	query := paginationQuery(options)
 
 	webhookList := &WebhookList{}
@@ -48,7 +48,7 @@ func ListWebhooks(c *messagebird.Client, options *ListOptions) (*WebhookList, er
 }
 
 // ReadWebhook gets a single webhook based on its ID.
-func ReadWebhook(c *messagebird.Client, id string) (*Webhook, error) {
+func ReadWebhook(c messagebird.Requester, id string) (*Webhook, error) {
 	webhook := &Webhook{}
 	if err := request(c, webhook, http.MethodGet, webhooksPath+"/"+id, nil); err != nil {
 		return nil, err
@@ -59,7 +59,7 @@ func ReadWebhook(c *messagebird.Client, id string) (*Webhook, error) {
 
 // UpdateWebhook updates a single webhook based on its ID with any values set in WebhookUpdateRequest.
 // Do not set any values that should not be updated.
-func UpdateWebhook(c *messagebird.Client, id string, req *WebhookUpdateRequest) (*Webhook, error) {
+func UpdateWebhook(c messagebird.Requester, id string, req *WebhookUpdateRequest) (*Webhook, error) {
 	webhook := &Webhook{}
 	if err := request(c, webhook, http.MethodPatch, webhooksPath+"/"+id, req); err != nil {
 		return nil, err
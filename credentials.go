@@ -0,0 +1,81 @@
+package messagebird
+
+import (
+	"context"
+	"sync"
+)
+
+// CredentialsProvider supplies the access key to authenticate a request with.
+// The client consults it for every request, which allows keys to be rotated
+// without creating new clients. Implementations must be safe for concurrent
+// use.
+type CredentialsProvider interface {
+	AccessKey(ctx context.Context) (string, error)
+}
+
+// CredentialsProviderFunc is an adapter to allow the use of ordinary functions
+// as CredentialsProviders.
+type CredentialsProviderFunc func(ctx context.Context) (string, error)
+
+// AccessKey calls f(ctx).
+func (f CredentialsProviderFunc) AccessKey(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// RotatingCredentials is a CredentialsProvider whose access key can be
+// replaced at any time, e.g. when a new key is read from a secret store.
+type RotatingCredentials struct {
+	mu        sync.RWMutex
+	accessKey string
+}
+
+// NewRotatingCredentials creates RotatingCredentials that initially provide
+// accessKey.
+func NewRotatingCredentials(accessKey string) *RotatingCredentials {
+	return &RotatingCredentials{accessKey: accessKey}
+}
+
+// AccessKey returns the current access key.
+func (r *RotatingCredentials) AccessKey(context.Context) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.accessKey, nil
+}
+
+// Rotate replaces the access key. Requests started after Rotate returns use
+// the new key.
+func (r *RotatingCredentials) Rotate(accessKey string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accessKey = accessKey
+}
+
+// accessKey returns the key to authenticate a request with: the one supplied
+// by Credentials if set, AccessKey otherwise. Errors of Credentials are
+// returned as a *credentialsError.
+func (c *Client) accessKey(ctx context.Context) (string, error) {
+	if c.Credentials != nil {
+		accessKey, err := c.Credentials.AccessKey(ctx)
+		if err != nil {
+			return "", &credentialsError{err}
+		}
+		return accessKey, nil
+	}
+	return c.AccessKey, nil
+}
+
+// credentialsError wraps an error of a CredentialsProvider, so requests that
+// could not be authenticated are not retried.
+type credentialsError struct {
+	err error
+}
+
+// Error implements the error interface.
+func (e *credentialsError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the CredentialsProvider.
+func (e *credentialsError) Unwrap() error {
+	return e.err
+}
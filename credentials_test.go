@@ -0,0 +1,58 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingCredentials(t *testing.T) {
+	var authorization string
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	creds := NewRotatingCredentials("first-key")
+	client.Credentials = creds
+
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, "AccessKey first-key", authorization)
+
+	creds.Rotate("second-key")
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, "AccessKey second-key", authorization)
+}
+
+func TestCredentialsProviderError(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	vaultErr := errors.New("vault unavailable")
+	client.Credentials = CredentialsProviderFunc(func(ctx context.Context) (string, error) {
+		return "", vaultErr
+	})
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+	assert.True(t, errors.Is(err, vaultErr))
+}
+
+func TestCredentialsProviderErrorNotRetried(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	var calls int
+	vaultErr := errors.New("key revoked")
+	client.Credentials = CredentialsProviderFunc(func(ctx context.Context) (string, error) {
+		calls++
+		return "", vaultErr
+	})
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+	assert.True(t, errors.Is(err, vaultErr))
+	assert.Equal(t, 1, calls)
+}
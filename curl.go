@@ -0,0 +1,68 @@
+package messagebird
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// CurlError is returned by clients with CurlOnError enabled when a request
+// fails. It wraps the original error, e.g. an ErrorResponse:
+//
+//	_, err := sms.Read(client, "some-id")
+//	var curlErr *messagebird.CurlError
+//	if errors.As(err, &curlErr) {
+//		log.Printf("%v, reproduce with: %s", curlErr.Err, curlErr.Command)
+//	}
+type CurlError struct {
+	Err     error  // The error returned by the request.
+	Command string // A curl command reproducing the request.
+}
+
+// Error implements the error interface.
+func (e *CurlError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *CurlError) Unwrap() error {
+	return e.Err
+}
+
+// curlCommand renders a request as a curl command. Secrets in the URL, body
+// and Authorization header are redacted. body must not be compressed.
+func (c *Client) curlCommand(ctx context.Context, method string, uri *url.URL, header http.Header, body []byte) string {
+	header = header.Clone()
+	header.Del("Content-Encoding")
+	// Setting Authorization first keeps addDefaultHeaders from asking the
+	// credentials provider for a key that is redacted anyway.
+	header.Set("Authorization", "AccessKey "+redacted)
+	c.addDefaultHeaders(ctx, header)
+
+	parts := []string{"curl", "-X", method}
+
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			parts = append(parts, "-H", shellQuote(name+": "+value))
+		}
+	}
+
+	if len(body) > 0 {
+		parts = append(parts, "--data-raw", shellQuote(string(redactBody(body))))
+	}
+
+	parts = append(parts, shellQuote(redactURL(c.resolveURL(uri))))
+	return strings.Join(parts, " ")
+}
+
+// shellQuote quotes s for use as a single argument in a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
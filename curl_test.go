@@ -0,0 +1,37 @@
+package messagebird
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurlOnError(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"errors":[{"code":10,"description":"token is invalid","parameter":"token"}]}`))
+	})
+
+	err := client.Request(nil, http.MethodPost, srv.URL+"/verify?access_key=secret", map[string]string{"token": "123456", "it's": "quoted"})
+	_, ok := err.(ErrorResponse)
+	assert.True(t, ok)
+
+	client.CurlOnError = true
+	err = client.Request(nil, http.MethodPost, srv.URL+"/verify?access_key=secret", map[string]string{"token": "123456", "it's": "quoted"})
+	assert.True(t, errors.Is(err, ErrInvalidParams))
+
+	var curlErr *CurlError
+	assert.True(t, errors.As(err, &curlErr))
+	assert.Equal(t, "API errors: token is invalid", curlErr.Error())
+	assert.Equal(t, "curl -X POST"+
+		" -H 'Accept: application/json'"+
+		" -H 'Accept-Encoding: gzip'"+
+		" -H 'Authorization: AccessKey [REDACTED]'"+
+		" -H 'Content-Type: application/json'"+
+		" -H 'User-Agent: MessageBird/ApiClient/"+ClientVersion+" Go/"+runtime.Version()+"'"+
+		` --data-raw '{"it'\''s":"quoted","token":"[REDACTED]"}'`+
+		" '"+srv.URL+"/verify?access_key=%5BREDACTED%5D'", curlErr.Command)
+}
@@ -0,0 +1,109 @@
+package messagebird
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// isMutating reports whether requests with the given method change state, and
+// are therefore not sent in dry-run mode.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// dryRun logs a request instead of sending it and decodes a synthetic
+// response into v. The response has a random ID, a href and a creation time,
+// and echoes the fields of body that fit v. Responses of the Voice API are
+// wrapped in a data array, like the API does.
+func (c *Client) dryRun(v interface{}, method string, uri *url.URL, body []byte) error {
+	if c.Logger != nil {
+		c.Logger.Printf("messagebird: dry run: %s %s %s", method, redactURL(uri), redactBody(body))
+	}
+	if v == nil || method == http.MethodDelete {
+		return nil
+	}
+
+	id, err := newIdempotencyKey()
+	if err != nil {
+		return err
+	}
+
+	// Different APIs use different names for the creation time.
+	now := time.Now().UTC().Format(time.RFC3339)
+	object := map[string]interface{}{
+		"createdDatetime": now,
+		"createdAt":       now,
+		"updatedAt":       now,
+	}
+	if method == http.MethodPost {
+		object["id"] = id
+		object["href"] = uri.String() + "/" + id
+	}
+
+	// Echo the request fields. If they don't all fit v, e.g. because
+	// request and response types of a field differ, only the fitting ones
+	// are echoed.
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err == nil {
+		merged := make(map[string]interface{}, len(fields)+len(object))
+		for name, value := range fields {
+			merged[name] = value
+		}
+		for name, value := range object {
+			merged[name] = value
+		}
+
+		if fitsDryRun(v, uri, merged) {
+			object = merged
+		} else {
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if _, ok := object[name]; ok {
+					continue
+				}
+				object[name] = fields[name]
+				if !fitsDryRun(v, uri, object) {
+					delete(object, name)
+				}
+			}
+		}
+	}
+
+	return decodeDryRun(v, uri, object)
+}
+
+// fitsDryRun reports whether object can be decoded into a value of the type
+// v points to.
+func fitsDryRun(v interface{}, uri *url.URL, object map[string]interface{}) bool {
+	t := reflect.TypeOf(v)
+	if t.Kind() != reflect.Ptr {
+		return false
+	}
+	return decodeDryRun(reflect.New(t.Elem()).Interface(), uri, object) == nil
+}
+
+// decodeDryRun decodes object into v, wrapped as the API of uri would.
+func decodeDryRun(v interface{}, uri *url.URL, object map[string]interface{}) error {
+	var response interface{} = object
+	if uri.Host == voiceHost {
+		response = map[string]interface{}{"data": []interface{}{object}}
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
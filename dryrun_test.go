@@ -0,0 +1,65 @@
+package messagebird
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRun(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "mutating request was sent")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"amount":9}`))
+	})
+	client.DryRun = true
+
+	var message struct {
+		ID              string
+		HRef            string
+		Originator      string
+		Body            string
+		CreatedDatetime *time.Time
+		Recipients      struct{ TotalCount int }
+	}
+	err := client.Request(&message, http.MethodPost, srv.URL+"/messages", map[string]interface{}{
+		"originator": "TestComp",
+		"body":       "Hello",
+		"recipients": []string{"31612345678"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, message.ID, 32)
+	assert.Equal(t, srv.URL+"/messages/"+message.ID, message.HRef)
+	assert.Equal(t, "TestComp", message.Originator)
+	assert.Equal(t, "Hello", message.Body)
+	assert.NotNil(t, message.CreatedDatetime)
+
+	assert.NoError(t, client.Request(nil, http.MethodDelete, srv.URL+"/messages/"+message.ID, nil))
+
+	var balance struct{ Amount int }
+	assert.NoError(t, client.Request(&balance, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, 9, balance.Amount)
+}
+
+func TestDryRunVoice(t *testing.T) {
+	client := New("test_gshuPaZoeEG6ovbc8M79w0QyM")
+	client.DryRun = true
+
+	var calls struct {
+		Data []struct {
+			ID          string
+			Source      string
+			Destination string
+		}
+	}
+	err := client.Request(&calls, http.MethodPost, "https://voice.messagebird.com/calls", map[string]interface{}{
+		"source":      "31644556677",
+		"destination": "33644556677",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, calls.Data, 1)
+	assert.NotEmpty(t, calls.Data[0].ID)
+	assert.Equal(t, "31644556677", calls.Data[0].Source)
+}
@@ -1,11 +1,50 @@
 package messagebird
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"strings"
 )
 
+// Error codes returned by the API. See
+// https://developers.messagebird.com/api/#api-errors for a complete overview.
+const (
+	ErrorCodeRequestNotAllowed = 2  // Request not allowed, e.g. due to an incorrect access key.
+	ErrorCodeMissingParams     = 9  // Missing parameters.
+	ErrorCodeInvalidParams     = 10 // Invalid parameters.
+	ErrorCodeNotFound          = 20 // Resource not found.
+	ErrorCodeBadRequest        = 21 // Bad request.
+	ErrorCodeNotEnoughBalance  = 25 // Not enough balance.
+	ErrorCodeAPINotFound       = 98 // The requested API could not be found.
+	ErrorCodeInternalError     = 99 // Internal error.
+)
+
+var (
+	// ErrUnauthorized matches errors indicating the request was not allowed,
+	// typically because of an incorrect access key.
+	ErrUnauthorized = errors.New("request not allowed")
+
+	// ErrNotFound matches errors indicating the requested resource does not
+	// exist.
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrInvalidParams matches errors indicating that parameters were missing
+	// or invalid. The Parameter field of the Error tells which one.
+	ErrInvalidParams = errors.New("invalid or missing parameters")
+
+	// ErrNotEnoughBalance matches errors indicating the account's balance is
+	// too low to send the message.
+	ErrNotEnoughBalance = errors.New("not enough balance")
+)
+
 // Error holds details including error code, human readable description and optional parameter that is related to the error.
+//
+// Errors returned by the client can be matched against ErrUnauthorized,
+// ErrNotFound, ErrInvalidParams and ErrNotEnoughBalance using errors.Is. Use errors.As to get the
+// first Error of an ErrorResponse.
 type Error struct {
 	Code        int
 	Description string
@@ -17,9 +56,73 @@ func (e Error) Error() string {
 	return e.Description
 }
 
+// Is reports whether the error's code matches the target sentinel error.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.Code == ErrorCodeRequestNotAllowed
+	case ErrNotFound:
+		return e.Code == ErrorCodeNotFound
+	case ErrInvalidParams:
+		return e.Code == ErrorCodeMissingParams || e.Code == ErrorCodeInvalidParams
+	case ErrNotEnoughBalance:
+		return e.Code == ErrorCodeNotEnoughBalance
+	}
+	return false
+}
+
+// maxErrorBodySize is the number of bytes of a response body kept in a
+// ResponseDecodeError.
+const maxErrorBodySize = 1024
+
+// ResponseDecodeError is returned when a response could not be decoded, e.g.
+// because a proxy returned an HTML page instead of JSON. It holds the status
+// code and the start of the body to help diagnosing the failure.
+type ResponseDecodeError struct {
+	StatusCode int    // HTTP status code of the response.
+	Body       []byte // Up to the first 1024 bytes of the response body.
+	Err        error  // The decoding error.
+}
+
+func newResponseDecodeError(statusCode int, body []byte, err error) *ResponseDecodeError {
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+	return &ResponseDecodeError{
+		StatusCode: statusCode,
+		Body:       append([]byte(nil), body...),
+		Err:        err,
+	}
+}
+
+// Error implements the error interface.
+func (e *ResponseDecodeError) Error() string {
+	return fmt.Sprintf("could not decode response with status %d: %v: %q", e.StatusCode, e.Err, e.Body)
+}
+
+// Unwrap returns the decoding error.
+func (e *ResponseDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the response's status code matches the target sentinel
+// error.
+func (e *ResponseDecodeError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
 // ErrorResponse represents errored API response.
 type ErrorResponse struct {
 	Errors []Error `json:"errors"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
 }
 
 // Error implements error interface.
@@ -30,3 +133,79 @@ func (r ErrorResponse) Error() string {
 	}
 	return fmt.Sprintf("API errors: %s", strings.Join(inners, ", "))
 }
+
+// Is reports whether any of the response's errors, or its status code, matches
+// the target sentinel error.
+func (r ErrorResponse) Is(target error) bool {
+	for _, inner := range r.Errors {
+		if inner.Is(target) {
+			return true
+		}
+	}
+	switch target {
+	case ErrUnauthorized:
+		return r.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return r.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// IsTemporary reports whether err is a transient failure, so the request may
+// succeed when it's retried later: the request was rate limited, timed out or
+// failed because of a network or server error. Other errors, like invalid
+// parameters or a balance that is too low, are permanent and will fail again
+// when retried.
+func IsTemporary(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrUnexpectedResponse) {
+		return true
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+
+	var errResp ErrorResponse
+	if errors.As(err, &errResp) {
+		for _, inner := range errResp.Errors {
+			if inner.Code == ErrorCodeInternalError {
+				return true
+			}
+		}
+		return errResp.StatusCode >= http.StatusInternalServerError
+	}
+
+	var decodeErr *ResponseDecodeError
+	if errors.As(err, &decodeErr) {
+		return decodeErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	// Failing to connect to the API or losing the connection, e.g. because
+	// it was refused or reset, is temporary unless the host doesn't exist.
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		var dnsErr *net.DNSError
+		return !errors.As(err, &dnsErr) || !dnsErr.IsNotFound
+	}
+
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// As sets target to the first Error of the response if target is an *Error.
+func (r ErrorResponse) As(target interface{}) bool {
+	e, ok := target.(*Error)
+	if !ok || len(r.Errors) == 0 {
+		return false
+	}
+	*e = r.Errors[0]
+	return true
+}
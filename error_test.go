@@ -1,8 +1,18 @@
 package messagebird
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"syscall"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestError(t *testing.T) {
@@ -37,3 +47,135 @@ func TestError(t *testing.T) {
 		assert.Error(t, errRes)
 	})
 }
+
+func TestErrorIs(t *testing.T) {
+	errRes := ErrorResponse{
+		Errors: []Error{
+			{
+				Code:        ErrorCodeInvalidParams,
+				Description: "recipient is invalid",
+				Parameter:   "recipient",
+			},
+		},
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+
+	assert.True(t, errors.Is(errRes, ErrInvalidParams))
+	assert.False(t, errors.Is(errRes, ErrNotFound))
+	assert.False(t, errors.Is(errRes, ErrUnauthorized))
+
+	wrapped := fmt.Errorf("creating verify: %w", errRes)
+	assert.True(t, errors.Is(wrapped, ErrInvalidParams))
+
+	var apiErr Error
+	assert.True(t, errors.As(wrapped, &apiErr))
+	assert.Equal(t, ErrorCodeInvalidParams, apiErr.Code)
+	assert.Equal(t, "recipient", apiErr.Parameter)
+
+	notFound := ErrorResponse{StatusCode: http.StatusNotFound}
+	assert.True(t, errors.Is(notFound, ErrNotFound))
+	assert.False(t, errors.As(notFound, &apiErr))
+}
+
+func TestRequestErrorStatusCode(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":20,"description":"message not found","parameter":null}]}`))
+	})
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/messages/some-id", nil)
+	errRes, ok := err.(ErrorResponse)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, errRes.StatusCode)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestResponseDecodeError(t *testing.T) {
+	page := "<html>" + strings.Repeat("Checking your browser. ", 100) + "</html>"
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/challenge":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(page))
+		case "/shape":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"unexpected"}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`not json`))
+		}
+	})
+
+	var decodeErr *ResponseDecodeError
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/challenge", nil)
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, http.StatusForbidden, decodeErr.StatusCode)
+	assert.Equal(t, page[:1024], string(decodeErr.Body))
+
+	err = client.Request(nil, http.MethodGet, srv.URL+"/shape", nil)
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, `{"message":"unexpected"}`, string(decodeErr.Body))
+	assert.Contains(t, err.Error(), "status 400")
+
+	var v struct{}
+	err = client.Request(&v, http.MethodGet, srv.URL+"/ok", nil)
+	assert.True(t, errors.As(err, &decodeErr))
+	assert.Equal(t, http.StatusOK, decodeErr.StatusCode)
+	assert.Equal(t, "not json", string(decodeErr.Body))
+}
+
+func TestErrorIsNotEnoughBalance(t *testing.T) {
+	errRes := ErrorResponse{
+		Errors:     []Error{{Code: ErrorCodeNotEnoughBalance, Description: "Not enough balance"}},
+		StatusCode: http.StatusPaymentRequired,
+	}
+
+	assert.True(t, errors.Is(errRes, ErrNotEnoughBalance))
+	assert.False(t, errors.Is(errRes, ErrInvalidParams))
+	assert.False(t, IsTemporary(errRes))
+}
+
+func TestIsTemporary(t *testing.T) {
+	tt := []struct {
+		name      string
+		err       error
+		temporary bool
+	}{
+		{"nil", nil, false},
+		{"rate limited", &RateLimitError{Err: ErrorResponse{StatusCode: http.StatusTooManyRequests}}, true},
+		{"internal error", ErrorResponse{Errors: []Error{{Code: ErrorCodeInternalError}}, StatusCode: http.StatusInternalServerError}, true},
+		{"bad gateway", &ResponseDecodeError{StatusCode: http.StatusBadGateway}, true},
+		{"invalid params", fmt.Errorf("sending: %w", ErrorResponse{Errors: []Error{{Code: ErrorCodeInvalidParams}}, StatusCode: http.StatusUnprocessableEntity}), false},
+		{"unauthorized", ErrorResponse{Errors: []Error{{Code: ErrorCodeRequestNotAllowed}}, StatusCode: http.StatusUnauthorized}, false},
+		{"deadline exceeded", fmt.Errorf("sending: %w", context.DeadlineExceeded), true},
+		{"canceled", context.Canceled, false},
+		{"canceled request", &url.Error{Op: "Get", URL: "https://rest.messagebird.com/balance", Err: context.Canceled}, false},
+		{"server error", ErrUnexpectedResponse, true},
+		{"connection refused", &url.Error{Op: "Get", URL: "https://rest.messagebird.com/balance", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}, true},
+		{"connection reset", &url.Error{Op: "Get", URL: "https://rest.messagebird.com/balance", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}, true},
+		{"unknown host", &url.Error{Op: "Get", URL: "https://rest.messagebird.invalid/balance", Err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "rest.messagebird.invalid", IsNotFound: true}}}, false},
+		{"client-side validation", ErrInvalidParams, false},
+	}
+
+	for _, tc := range tt {
+		assert.Equal(t, tc.temporary, IsTemporary(tc.err), tc.name)
+	}
+}
+
+func TestIsTemporaryServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := New("test_key")
+	err := client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+	assert.True(t, IsTemporary(err), "500 response: %v", err)
+
+	addr := srv.Listener.Addr().String()
+	srv.Close()
+
+	err = client.Request(nil, http.MethodGet, "http://"+addr+"/balance", nil)
+	assert.True(t, IsTemporary(err), "connection refused: %v", err)
+}
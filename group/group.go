@@ -69,7 +69,7 @@ var DefaultListOptions = &ListOptions{
 	Offset: 0,
 }
 
-func Create(c *messagebird.Client, request *Request) (*Group, error) {
+func Create(c messagebird.Requester, request *Request) (*Group, error) {
 	if err := validateCreate(request); err != nil {
 		return nil, err
 	}
@@ -92,13 +92,13 @@ func validateCreate(request *Request) error {
 
 // Delete attempts deleting the group with the provided ID. If nil is returned,
 // the resource was deleted successfully.
-func Delete(c *messagebird.Client, id string) error {
+func Delete(c messagebird.Requester, id string) error {
 	return c.Request(nil, http.MethodDelete, path+"/"+id, nil)
 }
 
 // List retrieves a paginated list of groups, based on the options provided.
 // It's worth noting DefaultListOptions.
-func List(c *messagebird.Client, options *ListOptions) (*GroupList, error) {
+func List(c messagebird.Requester, options *ListOptions) (*GroupList, error) {
 	query, err := listQuery(options)
 	if err != nil {
 		return nil, err
@@ -129,7 +129,7 @@ func listQuery(options *ListOptions) (string, error) {
 }
 
 // Read retrieves the information of an existing group.
-func Read(c *messagebird.Client, id string) (*Group, error) {
+func Read(c messagebird.Requester, id string) (*Group, error) {
 	group := &Group{}
 	if err := c.Request(group, http.MethodGet, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -139,7 +139,7 @@ func Read(c *messagebird.Client, id string) (*Group, error) {
 }
 
 // Update overrides the group with any values provided in request.
-func Update(c *messagebird.Client, id string, request *Request) error {
+func Update(c messagebird.Requester, id string, request *Request) error {
 	if err := validateUpdate(request); err != nil {
 		return err
 	}
@@ -156,7 +156,7 @@ func validateUpdate(request *Request) error {
 }
 
 // AddContacts adds a maximum of 50 contacts to the group.
-func AddContacts(c *messagebird.Client, groupID string, contactIDs []string) error {
+func AddContacts(c messagebird.Requester, groupID string, contactIDs []string) error {
 	if err := validateAddContacts(contactIDs); err != nil {
 		return err
 	}
@@ -196,7 +196,7 @@ func addContactsData(contactIDs []string) string {
 }
 
 // ListContacts lists the contacts that are a member of a group.
-func ListContacts(c *messagebird.Client, groupID string, options *ListOptions) (*contact.ContactList, error) {
+func ListContacts(c messagebird.Requester, groupID string, options *ListOptions) (*contact.ContactList, error) {
 	query, err := listQuery(options)
 	if err != nil {
 		return nil, err
@@ -214,7 +214,7 @@ func ListContacts(c *messagebird.Client, groupID string, options *ListOptions) (
 
 // RemoveContact removes the contact from a group. If nil is returned, the
 // operation was successful.
-func RemoveContact(c *messagebird.Client, groupID, contactID string) error {
+func RemoveContact(c messagebird.Requester, groupID, contactID string) error {
 	formattedPath := fmt.Sprintf("%s/%s/contacts/%s", path, groupID, contactID)
 
 	return c.Request(nil, http.MethodDelete, formattedPath, nil)
@@ -0,0 +1,46 @@
+package messagebird
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// gzipEncoding is the value of the Accept-Encoding and Content-Encoding headers
+// for gzip compressed bodies.
+const gzipEncoding = "gzip"
+
+// compressBody gzip compresses body if the client is configured to compress
+// bodies of its size. It reports whether body was compressed.
+func (c *Client) compressBody(body []byte) ([]byte, bool, error) {
+	if c.CompressRequestsOver <= 0 || len(body) < c.CompressRequestsOver {
+		return body, false, nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, false, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, false, err
+	}
+	return buf.Bytes(), true, nil
+}
+
+// readResponseBody reads the body of response, decompressing it if the server
+// gzip compressed it.
+func readResponseBody(response *http.Response) ([]byte, error) {
+	var r io.Reader = response.Body
+	if response.Header.Get("Content-Encoding") == gzipEncoding {
+		gr, err := gzip.NewReader(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		r = gr
+	}
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,58 @@
+package messagebird
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGzipResponse(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		gw := gzip.NewWriter(w)
+		gw.Write([]byte(`{"amount":9}`))
+		gw.Close()
+	})
+
+	var v struct{ Amount int }
+	assert.NoError(t, client.Request(&v, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, 9, v.Amount)
+}
+
+func TestCompressRequestsOver(t *testing.T) {
+	var encodings, bodies []string
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gr, err := gzip.NewReader(bytes.NewReader(body))
+			assert.NoError(t, err)
+			body, err = ioutil.ReadAll(gr)
+			assert.NoError(t, err)
+		}
+		bodies = append(bodies, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	client.CompressRequestsOver = 100
+
+	small := map[string]string{"body": "Hello"}
+	large := map[string]string{"body": strings.Repeat("Hello ", 50)}
+	assert.NoError(t, client.Request(nil, http.MethodPost, srv.URL+"/messages", small))
+	assert.NoError(t, client.Request(nil, http.MethodPost, srv.URL+"/messages", large))
+
+	assert.Equal(t, []string{"", "gzip"}, encodings)
+	assert.Equal(t, `{"body":"Hello"}`, bodies[0])
+	assert.Equal(t, `{"body":"`+strings.Repeat("Hello ", 50)+`"}`, bodies[1])
+}
@@ -0,0 +1,34 @@
+package messagebird
+
+import "time"
+
+// Hooks are callbacks that are invoked for every attempt of a request made
+// through Request, e.g. to feed an audit log. Any of them may be nil. Hooks
+// are called synchronously, so they should return quickly.
+type Hooks struct {
+	// OnRequest is called before an attempt is sent.
+	OnRequest func(RequestInfo)
+
+	// OnResponse is called when a response to an attempt was received,
+	// regardless of its status code.
+	OnResponse func(ResponseInfo)
+
+	// OnError is called when an attempt failed without a response, e.g.
+	// because of a network error or a timeout.
+	OnError func(RequestInfo, error)
+}
+
+// RequestInfo describes an attempt of a request.
+type RequestInfo struct {
+	Method  string // HTTP method.
+	Host    string // Host of the API, before applying BaseURL and APIBaseURLs.
+	Path    string // Path of the endpoint, e.g. "/messages".
+	Attempt int    // Number of the attempt, starting at 1.
+}
+
+// ResponseInfo describes the response to an attempt of a request.
+type ResponseInfo struct {
+	RequestInfo
+	StatusCode int           // HTTP status code of the response.
+	Duration   time.Duration // Time it took to receive the response.
+}
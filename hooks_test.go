@@ -0,0 +1,55 @@
+package messagebird
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.MinBackoff = time.Millisecond
+
+	u, _ := url.Parse(srv.URL)
+
+	var events []string
+	client.Hooks = Hooks{
+		OnRequest: func(info RequestInfo) {
+			events = append(events, fmt.Sprintf("request %s %s %s #%d", info.Method, info.Host, info.Path, info.Attempt))
+		},
+		OnResponse: func(info ResponseInfo) {
+			events = append(events, fmt.Sprintf("response %d #%d", info.StatusCode, info.Attempt))
+		},
+		OnError: func(info RequestInfo, err error) {
+			events = append(events, "error")
+		},
+	}
+
+	assert.NoError(t, client.Request(nil, http.MethodDelete, srv.URL+"/messages/id", nil))
+	assert.Equal(t, []string{
+		"request DELETE " + u.Host + " /messages/id #1",
+		"response 502 #1",
+		"request DELETE " + u.Host + " /messages/id #2",
+		"response 204 #2",
+	}, events)
+
+	events = nil
+	srv.Close()
+	assert.Error(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, "error", events[len(events)-1])
+	assert.True(t, strings.HasPrefix(events[0], "request GET"))
+}
@@ -0,0 +1,30 @@
+// Package sentinel wraps errors with the sentinel error they were mapped to,
+// so both match errors.Is.
+package sentinel
+
+// Wrap returns an error that matches sentinel with errors.Is, and unwraps to
+// err. Its message is that of sentinel, followed by that of err.
+func Wrap(sentinel, err error) error {
+	return &wrappedError{sentinel, err}
+}
+
+// wrappedError wraps an error with the sentinel error it was mapped to.
+type wrappedError struct {
+	sentinel error
+	err      error
+}
+
+// Error implements the error interface.
+func (e *wrappedError) Error() string {
+	return e.sentinel.Error() + ": " + e.err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *wrappedError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether target is the sentinel error.
+func (e *wrappedError) Is(target error) bool {
+	return target == e.sentinel
+}
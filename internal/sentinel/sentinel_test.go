@@ -0,0 +1,19 @@
+package sentinel
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrap(t *testing.T) {
+	errSentinel := errors.New("sentinel")
+	errCause := errors.New("cause")
+
+	err := Wrap(errSentinel, errCause)
+	assert.EqualError(t, err, "sentinel: cause")
+	assert.True(t, errors.Is(err, errSentinel))
+	assert.True(t, errors.Is(err, errCause))
+	assert.Equal(t, errCause, errors.Unwrap(err))
+}
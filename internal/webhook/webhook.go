@@ -0,0 +1,32 @@
+// Package webhook implements the HTTP handlers of the webhooks the API calls,
+// e.g. for status reports and inbound messages.
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/messagebird/go-rest-api/v7/signature"
+)
+
+// Handler returns a handler that parses requests with parse and passes the
+// result to fn. If validator is not nil, requests with an invalid signature are
+// rejected with 401 Unauthorized. Requests parse fails for are rejected with
+// 400 Bad Request, without calling fn.
+func Handler[T any](validator *signature.Validator, parse func(*http.Request) (T, error), fn func(T)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if validator != nil {
+			if err := validator.ValidRequest(r); err != nil {
+				http.Error(w, "", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		v, err := parse(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fn(v)
+		w.WriteHeader(http.StatusOK)
+	})
+}
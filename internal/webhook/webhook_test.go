@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/messagebird/go-rest-api/v7/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseID(r *http.Request) (string, error) {
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		return "", errors.New("id is missing")
+	}
+	return id, nil
+}
+
+func TestHandler(t *testing.T) {
+	var ids []string
+	h := Handler(nil, parseID, func(id string) { ids = append(ids, id) })
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?id=id", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "id is missing\n", w.Body.String())
+
+	assert.Equal(t, []string{"id"}, ids)
+}
+
+func TestHandlerInvalidSignature(t *testing.T) {
+	called := false
+	h := Handler(signature.NewValidator("secret"), parseID, func(string) { called = true })
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?id=id", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.False(t, called)
+}
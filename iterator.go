@@ -0,0 +1,110 @@
+package messagebird
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// DefaultPageSize is the number of items an Iterator requests per page when no
+// page size is given.
+const DefaultPageSize = 20
+
+// PageFunc fetches a single page of a collection that is paginated using
+// offset and limit. It returns the items on the page as a slice and the total
+// number of items in the collection.
+type PageFunc func(ctx context.Context, offset, limit int) (items interface{}, totalCount int, err error)
+
+// An Iterator iterates over all items of a paginated collection, transparently
+// fetching subsequent pages. It is not safe for concurrent use.
+//
+//	it := messagebird.NewIterator(ctx, 0, fetchPage)
+//	for it.Next() {
+//		item := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle error.
+//	}
+type Iterator struct {
+	ctx      context.Context
+	fetch    PageFunc
+	pageSize int
+
+	offset int
+	page   reflect.Value
+	index  int
+	value  interface{}
+	done   bool
+	err    error
+}
+
+// NewIterator creates an Iterator that fetches pages of pageSize items using
+// fetch. A pageSize of zero or less means DefaultPageSize. Iteration stops
+// when ctx is done.
+func NewIterator(ctx context.Context, pageSize int, fetch PageFunc) *Iterator {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &Iterator{
+		ctx:      ctx,
+		fetch:    fetch,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next item, fetching a new page if needed.
+// It returns false when all items have been visited or an error occurred.
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	if !it.page.IsValid() || it.index >= it.page.Len() {
+		if it.done || !it.fetchPage() {
+			return false
+		}
+	}
+
+	it.value = it.page.Index(it.index).Interface()
+	it.index++
+	return true
+}
+
+// fetchPage fetches the page at the current offset. It returns false if no
+// items were returned.
+func (it *Iterator) fetchPage() bool {
+	items, totalCount, err := it.fetch(it.ctx, it.offset, it.pageSize)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	page := reflect.ValueOf(items)
+	if page.Kind() != reflect.Slice {
+		it.err = fmt.Errorf("page items must be a slice, got %T", items)
+		return false
+	}
+
+	it.page = page
+	it.index = 0
+	it.offset += page.Len()
+	it.done = page.Len() == 0 || it.offset >= totalCount
+
+	return page.Len() > 0
+}
+
+// Value returns the current item. It must only be called after a call to Next
+// returned true.
+func (it *Iterator) Value() interface{} {
+	return it.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
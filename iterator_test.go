@@ -0,0 +1,56 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	all := []string{"a", "b", "c", "d", "e"}
+
+	var offsets []int
+	it := NewIterator(context.Background(), 2, func(ctx context.Context, offset, limit int) (interface{}, int, error) {
+		offsets = append(offsets, offset)
+		end := offset + limit
+		if end > len(all) {
+			end = len(all)
+		}
+		return all[offset:end], len(all), nil
+	})
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Value().(string))
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, all, got)
+	assert.Equal(t, []int{0, 2, 4}, offsets)
+}
+
+func TestIteratorError(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	it := NewIterator(context.Background(), 0, func(ctx context.Context, offset, limit int) (interface{}, int, error) {
+		assert.Equal(t, DefaultPageSize, limit)
+		return nil, 0, fetchErr
+	})
+
+	assert.False(t, it.Next())
+	assert.Equal(t, fetchErr, it.Err())
+	assert.False(t, it.Next())
+}
+
+func TestIteratorContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := NewIterator(ctx, 1, func(ctx context.Context, offset, limit int) (interface{}, int, error) {
+		return []int{offset}, 10, nil
+	})
+
+	assert.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+}
@@ -0,0 +1,50 @@
+package messagebird
+
+import (
+	"net/url"
+	"regexp"
+)
+
+// Logger is the interface used by the client to log requests. *log.Logger
+// implements it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// redacted replaces secret values in logs.
+const redacted = "[REDACTED]"
+
+// secretParams lists query, form and JSON parameters whose values must never
+// be logged.
+var secretParams = []string{"token", "access_key", "accessKey", "signingKey"}
+
+var (
+	jsonSecretRegexp = regexp.MustCompile(`("(?:token|access_key|accessKey|signingKey)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	formSecretRegexp = regexp.MustCompile(`(^|&)(token|access_key|accessKey|signingKey)=[^&]*`)
+)
+
+// redactURL returns u as a string with secret query parameters redacted.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	changed := false
+	for _, param := range secretParams {
+		if _, ok := query[param]; ok {
+			query.Set(param, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+
+	r := *u
+	r.RawQuery = query.Encode()
+	return r.String()
+}
+
+// redactBody returns a JSON or form encoded request body with secret values
+// redacted.
+func redactBody(body []byte) []byte {
+	body = jsonSecretRegexp.ReplaceAll(body, []byte(`$1"`+redacted+`"`))
+	return formSecretRegexp.ReplaceAll(body, []byte(`$1$2=`+redacted))
+}
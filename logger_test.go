@@ -0,0 +1,41 @@
+package messagebird
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogger(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	var buf bytes.Buffer
+	client.Logger = log.New(&buf, "", 0)
+
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/verify/some-id?token=123456", nil))
+
+	logged := buf.String()
+	assert.Contains(t, logged, "GET "+srv.URL+"/verify/some-id?token=%5BREDACTED%5D 200 in ")
+	assert.NotContains(t, logged, "123456")
+	assert.NotContains(t, logged, client.AccessKey)
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://rest.messagebird.com/verify/some-id?token=123456&foo=bar")
+	assert.Equal(t, "https://rest.messagebird.com/verify/some-id?foo=bar&token=%5BREDACTED%5D", redactURL(u))
+
+	u, _ = url.Parse("https://rest.messagebird.com/balance")
+	assert.Equal(t, "https://rest.messagebird.com/balance", redactURL(u))
+}
+
+func TestRedactBody(t *testing.T) {
+	assert.Equal(t, `{"id":"1","token":"[REDACTED]"}`, string(redactBody([]byte(`{"id":"1","token":"12\"34"}`))))
+	assert.Equal(t, `id=1&token=[REDACTED]&foo=bar`, string(redactBody([]byte(`id=1&token=1234&foo=bar`))))
+	assert.Equal(t, `{"body":"my token is safe"}`, string(redactBody([]byte(`{"body":"my token is safe"}`))))
+}
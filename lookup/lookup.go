@@ -45,7 +45,7 @@ const hlrPath = "hlr"
 const lookupPath = "lookup"
 
 // Read performs a new lookup for the specified number.
-func Read(c *messagebird.Client, phoneNumber string, params *Params) (*Lookup, error) {
+func Read(c messagebird.Requester, phoneNumber string, params *Params) (*Lookup, error) {
 	urlParams := paramsForLookup(params)
 	path := lookupPath + "/" + phoneNumber + "?" + urlParams.Encode()
 
@@ -58,7 +58,7 @@ func Read(c *messagebird.Client, phoneNumber string, params *Params) (*Lookup, e
 }
 
 // CreateHLR creates a new HLR lookup for the specified number.
-func CreateHLR(c *messagebird.Client, phoneNumber string, params *Params) (*hlr.HLR, error) {
+func CreateHLR(c messagebird.Requester, phoneNumber string, params *Params) (*hlr.HLR, error) {
 	requestData := requestDataForLookup(params)
 	path := lookupPath + "/" + phoneNumber + "/" + hlrPath
 
@@ -71,7 +71,7 @@ func CreateHLR(c *messagebird.Client, phoneNumber string, params *Params) (*hlr.
 }
 
 // ReadHLR performs a HLR lookup for the specified number.
-func ReadHLR(c *messagebird.Client, phoneNumber string, params *Params) (*hlr.HLR, error) {
+func ReadHLR(c messagebird.Requester, phoneNumber string, params *Params) (*hlr.HLR, error) {
 	urlParams := paramsForLookup(params)
 	path := lookupPath + "/" + phoneNumber + "/" + hlrPath + "?" + urlParams.Encode()
 
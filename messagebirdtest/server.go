@@ -0,0 +1,601 @@
+// Package messagebirdtest provides a fake MessageBird API server for use in
+// tests. It emulates the Verify, Messages and Balance resources of the REST
+// API and the webhooks of the Conversations API. State is kept in memory, so
+// objects created through the server can be read, verified and deleted again:
+//
+//	srv := messagebirdtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	v, _ := verify.Create(client, "31612345678", nil)
+//	token, _ := srv.VerifyToken(v.ID)
+//	v, _ = verify.VerifyToken(client, v.ID, token)
+//	// v.IsVerified() == true
+package messagebirdtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/verify"
+)
+
+const (
+	// DefaultToken is the one-time password assigned to verifications created
+	// through the server, unless Server.Token is changed.
+	DefaultToken = "123456"
+
+	// AccessKey is the access key used by clients returned by Server.Client.
+	AccessKey = "test_gshuPaZoeEG6ovbc8M79w0QyM"
+
+	// conversationsHost is the host of the Conversations API.
+	conversationsHost = "conversations.messagebird.com"
+
+	// conversationsPrefix is the path the Conversations API is served at.
+	conversationsPrefix = "/conversations"
+)
+
+// Message is an SMS message received by the server.
+type Message struct {
+	ID         string
+	Originator string
+	Body       string
+	Recipients []string
+}
+
+// A Server is a fake MessageBird API. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// Token is the one-time password assigned to new verifications. It
+	// defaults to DefaultToken.
+	Token string
+
+	mu       sync.Mutex
+	nextID   int
+	balance  float64
+	verifies map[string]*verifyObject
+	messages map[string]*messageObject
+	webhooks map[string]*webhookObject
+}
+
+type verifyObject struct {
+	ID                 string            `json:"id"`
+	HRef               string            `json:"href"`
+	Recipient          string            `json:"recipient"`
+	Reference          string            `json:"reference,omitempty"`
+	Messages           map[string]string `json:"messages"`
+	Status             string            `json:"status"`
+	CreatedDatetime    string            `json:"createdDatetime"`
+	ValidUntilDatetime string            `json:"validUntilDatetime"`
+
+	token   string
+	resends int
+}
+
+type messageObject struct {
+	ID              string              `json:"id"`
+	HRef            string              `json:"href"`
+	Direction       string              `json:"direction"`
+	Type            string              `json:"type"`
+	Originator      string              `json:"originator"`
+	Body            string              `json:"body"`
+	Reference       string              `json:"reference,omitempty"`
+	DataCoding      string              `json:"datacoding"`
+	MClass          int                 `json:"mclass"`
+	CreatedDatetime string              `json:"createdDatetime"`
+	Recipients      messageRecipients   `json:"recipients"`
+	TypeDetails     map[string]struct{} `json:"typeDetails"`
+
+	recipients []string
+}
+
+type messageRecipients struct {
+	TotalCount               int                `json:"totalCount"`
+	TotalSentCount           int                `json:"totalSentCount"`
+	TotalDeliveredCount      int                `json:"totalDeliveredCount"`
+	TotalDeliveryFailedCount int                `json:"totalDeliveryFailedCount"`
+	Items                    []messageRecipient `json:"items"`
+}
+
+type messageRecipient struct {
+	Recipient      int64  `json:"recipient"`
+	Status         string `json:"status"`
+	StatusDatetime string `json:"statusDatetime"`
+}
+
+type webhookObject struct {
+	ID              string   `json:"id"`
+	ChannelID       string   `json:"channelId"`
+	Events          []string `json:"events"`
+	URL             string   `json:"url"`
+	Status          string   `json:"status"`
+	CreatedDatetime string   `json:"createdDatetime"`
+	UpdatedDatetime *string  `json:"updatedDatetime"`
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Token:    DefaultToken,
+		balance:  100,
+		verifies: make(map[string]*verifyObject),
+		messages: make(map[string]*messageObject),
+		webhooks: make(map[string]*webhookObject),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a client that sends all requests to the server.
+func (s *Server) Client() *messagebird.Client {
+	client := messagebird.New(AccessKey)
+	client.BaseURL = s.URL
+	client.APIBaseURLs = map[string]string{
+		conversationsHost: s.URL + conversationsPrefix,
+	}
+	return client
+}
+
+// SetBalance sets the amount of credits returned by the balance resource.
+func (s *Server) SetBalance(amount float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.balance = amount
+}
+
+// VerifyToken returns the one-time password of the verification with the
+// given ID.
+func (s *Server) VerifyToken(id string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.verifies[id]
+	if !ok {
+		return "", false
+	}
+	return v.token, true
+}
+
+// VerifyResends returns the number of times the token of the verification
+// with the given ID was resent.
+func (s *Server) VerifyResends(id string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.verifies[id]; ok {
+		return v.resends
+	}
+	return 0
+}
+
+// Messages returns all SMS messages received by the server, in the order they
+// were sent.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var messages []Message
+	for _, m := range s.sortedMessages() {
+		messages = append(messages, Message{
+			ID:         m.ID,
+			Originator: m.Originator,
+			Body:       m.Body,
+			Recipients: m.recipients,
+		})
+	}
+	return messages
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "AccessKey")) == "" {
+		writeError(w, http.StatusUnauthorized, messagebird.ErrorCodeRequestNotAllowed, "Request not allowed (incorrect access_key)", "access_key")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resource, id := splitPath(r.URL.Path)
+	switch resource {
+	case "balance":
+		s.serveBalance(w, r)
+	case "verify":
+		s.serveVerify(w, r, id)
+	case "messages":
+		s.serveMessages(w, r, id)
+	case "conversations/v1/webhooks":
+		s.serveWebhooks(w, r, id)
+	default:
+		writeError(w, http.StatusNotFound, messagebird.ErrorCodeAPINotFound, "API not found", "")
+	}
+}
+
+// splitPath splits a path like /verify/some-id into its resource and ID.
+func splitPath(path string) (resource, id string) {
+	path = strings.Trim(path, "/")
+	for _, resource := range []string{"balance", "verify", "messages", "conversations/v1/webhooks"} {
+		if path == resource {
+			return resource, ""
+		}
+		if strings.HasPrefix(path, resource+"/") {
+			return resource, strings.TrimPrefix(path, resource+"/")
+		}
+	}
+	return path, ""
+}
+
+// splitAction splits an ID like some-id/resend into the ID and the action.
+func splitAction(id string) (string, string) {
+	if i := strings.Index(id, "/"); i >= 0 {
+		return id[:i], id[i+1:]
+	}
+	return id, ""
+}
+
+func (s *Server) serveBalance(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"payment": "prepaid",
+		"type":    "credits",
+		"amount":  s.balance,
+	})
+}
+
+func (s *Server) serveVerify(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		if r.Method != http.MethodPost {
+			writeMethodNotAllowed(w)
+			return
+		}
+		s.createVerify(w, r)
+		return
+	}
+
+	id, action := splitAction(id)
+	v, ok := s.verifies[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, messagebird.ErrorCodeNotFound, "Verify object could not be found", "id")
+		return
+	}
+
+	if action == "resend" && r.Method == http.MethodPost {
+		if v.Status != verify.StatusSent {
+			writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeInvalidParams, "The verification is no longer pending.", "id")
+			return
+		}
+		v.resends++
+		writeJSON(w, http.StatusOK, v)
+		return
+	} else if action != "" {
+		writeError(w, http.StatusNotFound, messagebird.ErrorCodeAPINotFound, "API not found", "")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if token := r.URL.Query().Get("token"); token != "" {
+			s.checkToken(w, v, token)
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	case http.MethodPost:
+		var req struct {
+			Token string `json:"token"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Token == "" {
+			writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeMissingParams, "token is required", "token")
+			return
+		}
+		s.checkToken(w, v, req.Token)
+	case http.MethodDelete:
+		delete(s.verifies, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) checkToken(w http.ResponseWriter, v *verifyObject, token string) {
+	if token != v.token {
+		writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeInvalidParams, "The token is invalid.", "token")
+		return
+	}
+	v.Status = verify.StatusVerified
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) createVerify(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Recipient string `json:"recipient"`
+		Reference string `json:"reference"`
+		Timeout   int    `json:"timeout"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Recipient == "" {
+		writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeMissingParams, "recipient is required", "recipient")
+		return
+	}
+	if req.Timeout == 0 {
+		req.Timeout = 30
+	}
+
+	now := time.Now().UTC()
+	id := s.newID()
+	v := &verifyObject{
+		ID:        id,
+		HRef:      messagebird.Endpoint + "/verify/" + id,
+		Recipient: req.Recipient,
+		Reference: req.Reference,
+		Messages: map[string]string{
+			"href": messagebird.Endpoint + "/messages/" + s.newID(),
+		},
+		Status:             verify.StatusSent,
+		CreatedDatetime:    now.Format(time.RFC3339),
+		ValidUntilDatetime: now.Add(time.Duration(req.Timeout) * time.Second).Format(time.RFC3339),
+		token:              s.Token,
+	}
+	s.verifies[id] = v
+
+	writeJSON(w, http.StatusCreated, v)
+}
+
+func (s *Server) serveMessages(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listMessages(w, r)
+		case http.MethodPost:
+			s.createMessage(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	m, ok := s.messages[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, messagebird.ErrorCodeNotFound, "message not found", "id")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, m)
+	case http.MethodDelete:
+		delete(s.messages, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) createMessage(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Originator string   `json:"originator"`
+		Body       string   `json:"body"`
+		Recipients []string `json:"recipients"`
+		Type       string   `json:"type"`
+		Reference  string   `json:"reference"`
+		DataCoding string   `json:"datacoding"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeInvalidParams, "could not parse request", "")
+		return
+	}
+	if req.Originator == "" || req.Body == "" || len(req.Recipients) == 0 {
+		writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeMissingParams, "originator, body and recipients are required", "recipients")
+		return
+	}
+	if req.Type == "" {
+		req.Type = "sms"
+	}
+	if req.DataCoding == "" {
+		req.DataCoding = "plain"
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	items := make([]messageRecipient, len(req.Recipients))
+	for i, recipient := range req.Recipients {
+		msisdn, err := strconv.ParseInt(recipient, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeInvalidParams, "invalid recipient "+recipient, "recipients")
+			return
+		}
+		items[i] = messageRecipient{Recipient: msisdn, Status: "sent", StatusDatetime: now}
+	}
+
+	id := s.newID()
+	m := &messageObject{
+		ID:              id,
+		HRef:            messagebird.Endpoint + "/messages/" + id,
+		Direction:       "mt",
+		Type:            req.Type,
+		Originator:      req.Originator,
+		Body:            req.Body,
+		Reference:       req.Reference,
+		DataCoding:      req.DataCoding,
+		MClass:          1,
+		CreatedDatetime: now,
+		Recipients: messageRecipients{
+			TotalCount:     len(items),
+			TotalSentCount: len(items),
+			Items:          items,
+		},
+		TypeDetails: map[string]struct{}{},
+		recipients:  req.Recipients,
+	}
+	s.messages[id] = m
+
+	writeJSON(w, http.StatusCreated, m)
+}
+
+func (s *Server) listMessages(w http.ResponseWriter, r *http.Request) {
+	messages := s.sortedMessages()
+	offset, limit := pagination(r, len(messages))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"offset":     offset,
+		"limit":      limit,
+		"count":      len(messages[offset : limit+offset]),
+		"totalCount": len(messages),
+		"items":      messages[offset : limit+offset],
+	})
+}
+
+func (s *Server) sortedMessages() []*messageObject {
+	messages := make([]*messageObject, 0, len(s.messages))
+	for _, m := range s.messages {
+		messages = append(messages, m)
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+	return messages
+}
+
+func (s *Server) serveWebhooks(w http.ResponseWriter, r *http.Request, id string) {
+	if id == "" {
+		switch r.Method {
+		case http.MethodGet:
+			s.listWebhooks(w, r)
+		case http.MethodPost:
+			s.createWebhook(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	wh, ok := s.webhooks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, messagebird.ErrorCodeNotFound, "webhook not found", "id")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, wh)
+	case http.MethodPatch:
+		var req struct {
+			Events []string `json:"events"`
+			URL    string   `json:"url"`
+			Status string   `json:"status"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeInvalidParams, "could not parse request", "")
+			return
+		}
+		if req.Events != nil {
+			wh.Events = req.Events
+		}
+		if req.URL != "" {
+			wh.URL = req.URL
+		}
+		if req.Status != "" {
+			wh.Status = req.Status
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		wh.UpdatedDatetime = &now
+		writeJSON(w, http.StatusOK, wh)
+	case http.MethodDelete:
+		delete(s.webhooks, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ChannelID string   `json:"channelId"`
+		Events    []string `json:"events"`
+		URL       string   `json:"url"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.URL == "" || req.ChannelID == "" {
+		writeError(w, http.StatusUnprocessableEntity, messagebird.ErrorCodeMissingParams, "channelId and url are required", "url")
+		return
+	}
+
+	wh := &webhookObject{
+		ID:              s.newID(),
+		ChannelID:       req.ChannelID,
+		Events:          req.Events,
+		URL:             req.URL,
+		Status:          "enabled",
+		CreatedDatetime: time.Now().UTC().Format(time.RFC3339),
+	}
+	s.webhooks[wh.ID] = wh
+
+	writeJSON(w, http.StatusCreated, wh)
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks := make([]*webhookObject, 0, len(s.webhooks))
+	for _, wh := range s.webhooks {
+		webhooks = append(webhooks, wh)
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].ID < webhooks[j].ID })
+
+	offset, limit := pagination(r, len(webhooks))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"offset":     offset,
+		"limit":      limit,
+		"count":      len(webhooks[offset : offset+limit]),
+		"totalCount": len(webhooks),
+		"items":      webhooks[offset : offset+limit],
+	})
+}
+
+// newID returns a new unique ID resembling the 32 character hex IDs used by
+// the API. IDs increase, so they sort in creation order.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%032x", s.nextID)
+}
+
+// pagination reads the offset and limit query parameters and clamps them to
+// the number of available items. The returned limit is the number of items on
+// the page.
+func pagination(r *http.Request, total int) (offset, limit int) {
+	offset, _ = strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 || offset > total {
+		offset = total
+	}
+	if offset+limit > total {
+		limit = total - offset
+	}
+	return offset, limit
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status, code int, description, parameter string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"code":        code,
+				"description": description,
+				"parameter":   parameter,
+			},
+		},
+	})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, messagebird.ErrorCodeBadRequest, "method not allowed", "")
+}
@@ -0,0 +1,142 @@
+package messagebirdtest
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/balance"
+	"github.com/messagebird/go-rest-api/v7/conversation"
+	"github.com/messagebird/go-rest-api/v7/sms"
+	"github.com/messagebird/go-rest-api/v7/verify"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalance(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.SetBalance(9.2)
+
+	b, err := balance.Read(srv.Client())
+	assert.NoError(t, err)
+	assert.Equal(t, "prepaid", b.Payment)
+	assert.Equal(t, json.Number("9.2"), b.Amount)
+}
+
+func TestVerify(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	v, err := verify.Create(client, "31612345678", &verify.Params{Reference: "ref"})
+	assert.NoError(t, err)
+	assert.Len(t, v.ID, 32)
+	assert.Equal(t, "31612345678", v.Recipient)
+	assert.Equal(t, "ref", v.Reference)
+	assert.Equal(t, "sent", v.Status)
+
+	token, ok := srv.VerifyToken(v.ID)
+	assert.True(t, ok)
+	assert.Equal(t, DefaultToken, token)
+
+	_, err = verify.Resend(client, v.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, srv.VerifyResends(v.ID))
+
+	_, err = verify.VerifyToken(client, v.ID, "000000")
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+	assert.True(t, errors.Is(err, verify.ErrInvalidToken))
+
+	v, err = verify.VerifyToken(client, v.ID, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "verified", v.Status)
+
+	v, err = verify.CheckToken(client, v.ID, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "verified", v.Status)
+
+	assert.NoError(t, verify.Delete(client, v.ID))
+	_, err = verify.Read(client, v.ID)
+	assert.True(t, errors.Is(err, messagebird.ErrNotFound))
+}
+
+func TestMessages(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	first, err := sms.Create(client, "TestComp", []string{"31612345678", "31612345679"}, "Hello", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "TestComp", first.Originator)
+	assert.Equal(t, 2, first.Recipients.TotalCount)
+	assert.Equal(t, int64(31612345678), first.Recipients.Items[0].Recipient)
+
+	second, err := sms.Create(client, "TestComp", []string{"31612345678"}, "World", nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ID, second.ID)
+
+	read, err := sms.Read(client, first.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello", read.Body)
+
+	list, err := sms.List(client, &sms.ListParams{Limit: 1, Offset: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, list.TotalCount)
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, second.ID, list.Items[0].ID)
+
+	sent := srv.Messages()
+	assert.Len(t, sent, 2)
+	assert.Equal(t, []string{"31612345678", "31612345679"}, sent[0].Recipients)
+
+	_, err = sms.Delete(client, second.ID)
+	assert.NoError(t, err)
+	_, err = sms.Read(client, second.ID)
+	assert.True(t, errors.Is(err, messagebird.ErrNotFound))
+
+	_, err = sms.Create(client, "TestComp", []string{"not-a-number"}, "Hello", nil)
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+}
+
+func TestWebhooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	wh, err := conversation.CreateWebhook(client, &conversation.WebhookCreateRequest{
+		ChannelID: "chid",
+		Events:    []conversation.WebhookEvent{conversation.WebhookEventMessageCreated},
+		URL:       "https://example.com/webhooks",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "chid", wh.ChannelID)
+	assert.Equal(t, conversation.WebhookStatusEnabled, wh.Status)
+
+	wh, err = conversation.UpdateWebhook(client, wh.ID, &conversation.WebhookUpdateRequest{
+		Status: conversation.WebhookStatusDisabled,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, conversation.WebhookStatusDisabled, wh.Status)
+	assert.NotNil(t, wh.UpdatedDatetime)
+
+	list, err := conversation.ListWebhooks(client, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, list.TotalCount)
+
+	assert.NoError(t, conversation.DeleteWebhook(client, wh.ID))
+	_, err = conversation.ReadWebhook(client, wh.ID)
+	assert.True(t, errors.Is(err, messagebird.ErrNotFound))
+}
+
+func TestUnauthorized(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	client := srv.Client()
+	client.AccessKey = ""
+
+	_, err := balance.Read(client)
+	assert.True(t, errors.Is(err, messagebird.ErrUnauthorized))
+}
@@ -0,0 +1,101 @@
+package messagebird
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// A Doer sends an HTTP request and returns its response. *http.Client
+// implements it.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as Doers.
+type DoerFunc func(*http.Request) (*http.Response, error)
+
+// Do calls f(r).
+func (f DoerFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// Middleware wraps a Doer to add behaviour to every request the client sends,
+// e.g. logging, metrics or mutating outgoing requests. Implementations must
+// call next to have the request sent.
+type Middleware func(next Doer) Doer
+
+// Use adds middleware to the client's chain. Middleware is invoked in the order
+// it was added: the first middleware sees the request first and the response
+// last. Use is not safe to call concurrently with requests being made.
+func (c *Client) Use(mw ...Middleware) {
+	c.middleware = append(c.middleware, mw...)
+}
+
+// Do sends an HTTP request through the client's middleware chain and returns
+// the raw response. Authorization and User-Agent headers, the client's Header
+// and APIVersion, and headers added with WithHeader are added if the request
+// doesn't already have them, and the URL is rewritten according to
+// BaseURL and APIBaseURLs. The caller is responsible for closing the response
+// body.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	if u := c.resolveURL(req.URL); u != req.URL {
+		// DecodeResponse needs the URL before rewriting to tell which API
+		// the response is from.
+		req = req.WithContext(context.WithValue(req.Context(), originalURLContextKey, req.URL))
+		req.URL = u
+		req.Host = u.Host
+	}
+	if err := c.addDefaultHeaders(req.Context(), req.Header); err != nil {
+		return nil, err
+	}
+
+	var doer Doer = c.httpClient()
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		doer = c.middleware[i](doer)
+	}
+
+	start := time.Now()
+	resp, err := doer.Do(req)
+	if c.Logger != nil {
+		latency := time.Since(start)
+		if err != nil {
+			c.Logger.Printf("messagebird: %s %s failed after %s: %v", req.Method, redactURL(req.URL), latency, err)
+		} else {
+			c.Logger.Printf("messagebird: %s %s %d in %s", req.Method, redactURL(req.URL), resp.StatusCode, latency)
+		}
+	}
+
+	return resp, err
+}
+
+// addDefaultHeaders adds the headers that Do adds to every request to header,
+// if not present yet.
+func (c *Client) addDefaultHeaders(ctx context.Context, header http.Header) error {
+	if header.Get("Authorization") == "" {
+		accessKey, err := c.accessKey(ctx)
+		if err != nil {
+			return err
+		}
+		header.Set("Authorization", "AccessKey "+accessKey)
+	}
+	if header.Get("User-Agent") == "" {
+		header.Set("User-Agent", c.userAgent())
+	}
+	addHeaders(header, headerFromContext(ctx))
+	addHeaders(header, c.Header)
+	if c.APIVersion != "" && header.Get(APIVersionHeader) == "" {
+		header.Set(APIVersionHeader, c.APIVersion)
+	}
+	return nil
+}
+
+// addHeaders adds the values of the headers in src that are not yet present in
+// dst.
+func addHeaders(dst, src http.Header) {
+	for key, values := range src {
+		if _, ok := dst[key]; !ok {
+			dst[key] = append([]string(nil), values...)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package messagebird
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUse(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "AccessKey test_gshuPaZoeEG6ovbc8M79w0QyM", r.Header.Get("Authorization"))
+		assert.Equal(t, []string{"first", "second"}, r.Header.Values("X-Chain"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+
+	var responses []string
+	tag := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request) (*http.Response, error) {
+				r.Header.Add("X-Chain", name)
+				resp, err := next.Do(r)
+				responses = append(responses, name)
+				return resp, err
+			})
+		}
+	}
+	client.Use(tag("first"), tag("second"))
+
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, []string{"second", "first"}, responses)
+}
@@ -0,0 +1,137 @@
+package mms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/webhook"
+	"github.com/messagebird/go-rest-api/v7/signature"
+)
+
+// InboundMessage is an MMS message received on one of your numbers, as
+// forwarded to the URL configured for the number.
+type InboundMessage struct {
+	ID              string
+	Recipient       string // The number the message was sent to.
+	Originator      string // The sender of the message.
+	Subject         string
+	Body            string
+	Media           []InboundMedia
+	CreatedDatetime time.Time
+}
+
+// InboundMedia is a media attachment of an InboundMessage. Download it with
+// DownloadMedia.
+type InboundMedia struct {
+	URL         string
+	ContentType string // Empty if not reported.
+}
+
+// ParseInbound parses the inbound MMS message in r. Messages are forwarded as
+// query parameters or form-encoded POST bodies, with the media as the
+// mediaUrls[] and mediaContentTypes[] arrays.
+func ParseInbound(r *http.Request) (*InboundMessage, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid inbound message: %w", err)
+	}
+	form := r.Form
+
+	message := &InboundMessage{
+		ID:         form.Get("id"),
+		Recipient:  form.Get("recipient"),
+		Originator: form.Get("originator"),
+		Subject:    form.Get("subject"),
+		Body:       form.Get("body"),
+	}
+
+	if message.Originator == "" {
+		return nil, errors.New("invalid inbound message: originator is missing")
+	}
+	if message.Recipient == "" {
+		return nil, errors.New("invalid inbound message: recipient is missing")
+	}
+
+	contentTypes := form["mediaContentTypes[]"]
+	for i, mediaURL := range form["mediaUrls[]"] {
+		media := InboundMedia{URL: mediaURL}
+		if i < len(contentTypes) {
+			media.ContentType = contentTypes[i]
+		}
+		message.Media = append(message.Media, media)
+	}
+
+	if v := form.Get("createdDatetime"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inbound message: createdDatetime: %w", err)
+		}
+		message.CreatedDatetime = t
+	}
+
+	return message, nil
+}
+
+// InboundHandler returns a handler that parses inbound MMS messages and passes
+// them to fn. If validator is not nil, requests with an invalid signature are
+// rejected with 401 Unauthorized. Invalid messages are rejected with 400 Bad
+// Request.
+func InboundHandler(validator *signature.Validator, fn func(*InboundMessage)) http.Handler {
+	return webhook.Handler(validator, ParseInbound, fn)
+}
+
+// DownloadMedia downloads the media at mediaURL, e.g. the URL of an
+// InboundMedia. The caller must close the returned body.
+//
+// Media hosted by MessageBird is downloaded over HTTPS with c, which must be
+// able to send raw HTTP requests like *messagebird.Client does. Other media,
+// including media on MessageBird hosts over plain HTTP, is downloaded without
+// credentials, so the access key is never sent to a host that a webhook
+// happens to name, or over an unencrypted connection.
+func DownloadMedia(ctx context.Context, c messagebird.Requester, mediaURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mediaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var doer messagebird.Doer
+	if req.URL.Scheme == "https" && isMessageBirdHost(req.URL.Hostname()) {
+		var ok bool
+		if doer, ok = c.(messagebird.Doer); !ok {
+			return nil, errors.New("client can not send raw HTTP requests")
+		}
+	} else {
+		doer = plainHTTPClient(c)
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("bad HTTP status: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// isMessageBirdHost reports whether host belongs to MessageBird, and may
+// receive the client's access key.
+func isMessageBirdHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "messagebird.com" || strings.HasSuffix(host, ".messagebird.com")
+}
+
+// plainHTTPClient returns the HTTP client of c, if it has one, to send
+// requests that must not carry c's credentials.
+func plainHTTPClient(c messagebird.Requester) *http.Client {
+	if client, ok := c.(*messagebird.Client); ok && client.HTTPClient != nil {
+		return client.HTTPClient
+	}
+	return http.DefaultClient
+}
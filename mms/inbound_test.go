@@ -0,0 +1,138 @@
+package mms
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInbound(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/inbound?id=6d9e7100b1f9406c81a3c303c30ccf05&recipient=3197010260000&originator=31612345678&subject=Photo&body=Look&mediaUrls[]=https%3A%2F%2Fexample.com%2F1.jpg&mediaUrls[]=https%3A%2F%2Fexample.com%2F2.gif&mediaContentTypes[]=image%2Fjpeg&createdDatetime=2017-10-20T12%3A50%3A28%2B00%3A00", nil)
+
+	message, err := ParseInbound(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "6d9e7100b1f9406c81a3c303c30ccf05", message.ID)
+	assert.Equal(t, "3197010260000", message.Recipient)
+	assert.Equal(t, "31612345678", message.Originator)
+	assert.Equal(t, "Photo", message.Subject)
+	assert.Equal(t, "Look", message.Body)
+	assert.Equal(t, []InboundMedia{
+		{URL: "https://example.com/1.jpg", ContentType: "image/jpeg"},
+		{URL: "https://example.com/2.gif"},
+	}, message.Media)
+	assert.Equal(t, "2017-10-20T12:50:28Z", message.CreatedDatetime.UTC().Format(time.RFC3339))
+}
+
+func TestParseInboundInvalid(t *testing.T) {
+	for _, query := range []string{
+		"recipient=3197010260000&body=Hi",
+		"originator=31612345678&body=Hi",
+		"recipient=3197010260000&originator=31612345678&createdDatetime=now",
+	} {
+		_, err := ParseInbound(httptest.NewRequest(http.MethodGet, "/inbound?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+func TestInboundHandler(t *testing.T) {
+	var received []*InboundMessage
+	h := InboundHandler(nil, func(m *InboundMessage) { received = append(received, m) })
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/inbound?originator=31612345678&recipient=3197010260000&body=Hi", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/inbound?body=Hi", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Len(t, received, 1)
+}
+
+func TestDownloadMedia(t *testing.T) {
+	mbtest.WillReturn([]byte("GIF89a"), http.StatusOK)
+	client := mbtest.Client(t)
+
+	body, err := DownloadMedia(context.Background(), client, "https://rest.messagebird.com/mms/media/1.gif")
+	assert.NoError(t, err)
+	defer body.Close()
+
+	content, err := ioutil.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "GIF89a", string(content))
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/mms/media/1.gif")
+}
+
+func TestDownloadMediaNotFound(t *testing.T) {
+	mbtest.WillReturn([]byte(""), http.StatusNotFound)
+	client := mbtest.Client(t)
+
+	_, err := DownloadMedia(context.Background(), client, "https://rest.messagebird.com/mms/media/1.gif")
+	assert.EqualError(t, err, "bad HTTP status: 404")
+}
+
+func TestDownloadMediaForeignHost(t *testing.T) {
+	var authorization []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Values("Authorization")
+		w.Write([]byte("GIF89a"))
+	}))
+	defer srv.Close()
+
+	client := messagebird.New("live_secret")
+
+	body, err := DownloadMedia(context.Background(), client, srv.URL+"/1.gif")
+	assert.NoError(t, err)
+	defer body.Close()
+
+	content, err := ioutil.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "GIF89a", string(content))
+	assert.Empty(t, authorization)
+}
+
+// headerRecorder records the Authorization header of the requests it sends.
+type headerRecorder struct {
+	authorization []string
+}
+
+func (r *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.authorization = append(r.authorization, req.Header.Get("Authorization"))
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("GIF89a")),
+		Request:    req,
+	}, nil
+}
+
+func TestDownloadMediaPlainHTTP(t *testing.T) {
+	recorder := &headerRecorder{}
+	client := messagebird.New("live_secret")
+	client.HTTPClient = &http.Client{Transport: recorder}
+
+	body, err := DownloadMedia(context.Background(), client, "http://rest.messagebird.com/mms/media/1.gif")
+	assert.NoError(t, err)
+	body.Close()
+
+	body, err = DownloadMedia(context.Background(), client, "https://rest.messagebird.com/mms/media/1.gif")
+	assert.NoError(t, err)
+	body.Close()
+
+	assert.Equal(t, []string{"", "AccessKey live_secret"}, recorder.authorization)
+}
+
+func TestIsMessageBirdHost(t *testing.T) {
+	assert.True(t, isMessageBirdHost("rest.messagebird.com"))
+	assert.True(t, isMessageBirdHost("Media.MessageBird.com"))
+	assert.False(t, isMessageBirdHost("messagebird.com.example.com"))
+	assert.False(t, isMessageBirdHost("evilmessagebird.com"))
+	assert.False(t, isMessageBirdHost("example.com"))
+}
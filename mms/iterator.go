@@ -0,0 +1,58 @@
+package mms
+
+import (
+	"context"
+	"net/http"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// MessageIterator iterates over all MMS messages matching a ListParams,
+// transparently fetching subsequent pages.
+//
+//	it := mms.Iterate(ctx, client, &mms.ListParams{Status: "delivered"})
+//	for it.Next() {
+//		message := it.Message()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle error.
+//	}
+type MessageIterator struct {
+	*messagebird.Iterator
+}
+
+// Message returns the current message. It must only be called after a call to
+// Next returned true.
+func (it *MessageIterator) Message() *Message {
+	return it.Value().(*Message)
+}
+
+// Iterate returns an iterator over all MMS messages matching params. The Limit
+// of params is used as the page size and iteration starts at its Offset.
+// Requests are bound to ctx, and iteration stops when ctx is done.
+func Iterate(ctx context.Context, c messagebird.Requester, params *ListParams) *MessageIterator {
+	var p ListParams
+	if params != nil {
+		p = *params
+	}
+	start := p.Offset
+
+	fetch := func(ctx context.Context, offset, limit int) (interface{}, int, error) {
+		p.Offset = start + offset
+		p.Limit = limit
+
+		messageList := &MessageList{}
+		if err := messagebird.RequestContext(ctx, c, messageList, http.MethodGet, path+"?"+paramsForMessageList(&p).Encode(), nil); err != nil {
+			return nil, 0, err
+		}
+
+		items := make([]*Message, len(messageList.Items))
+		for i := range messageList.Items {
+			items[i] = &messageList.Items[i]
+		}
+		return items, messageList.TotalCount - start, nil
+	}
+
+	return &MessageIterator{messagebird.NewIterator(ctx, p.Limit, fetch)}
+}
@@ -0,0 +1,111 @@
+package mms
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// mediaRoot is the absolute URL of the API that hosts uploaded media.
+const mediaRoot = "https://messaging.messagebird.com/v1/files"
+
+// SupportedMediaTypes are the content types of media that can be attached to
+// MMS messages.
+var SupportedMediaTypes = []string{
+	"audio/basic", "audio/L24", "audio/mp4", "audio/mpeg", "audio/ogg",
+	"audio/vorbis", "audio/vnd.rn-realaudio", "audio/vnd.wave", "audio/3gpp",
+	"audio/3gpp2", "audio/ac3", "audio/webm", "audio/amr-nb", "audio/amr",
+	"video/mpeg", "video/mp4", "video/quicktime", "video/webm", "video/3gpp",
+	"video/3gpp2", "video/3gpp-tt", "video/H261", "video/H263",
+	"video/H263-1998", "video/H263-2000", "video/H264",
+	"image/jpeg", "image/gif", "image/png", "image/bmp",
+	"text/vcard", "text/csv", "text/rtf", "text/richtext", "text/calendar",
+	"application/pdf",
+}
+
+// Media is a media file uploaded with UploadMedia.
+type Media struct {
+	ID          string
+	ContentType string
+	Size        int64 // Size of the media in bytes.
+}
+
+// URL returns the URL of the media, to be used in Params.MediaUrls.
+func (m *Media) URL() string {
+	return mediaRoot + "/" + m.ID
+}
+
+// UploadMedia uploads the media in r, so it can be attached to MMS messages
+// without hosting it yourself. If contentType is empty, it is detected from
+// the contents of r. It returns an error if the content type is not one of
+// SupportedMediaTypes, or if the media is larger than MaxMediaSize.
+//
+//	f, _ := os.Open("image.png")
+//	defer f.Close()
+//	media, err := mms.UploadMedia(client, "image/png", f)
+//	// ...
+//	message, err := mms.Create(client, "TestName", recipients, &mms.Params{
+//		MediaUrls: []string{media.URL()},
+//	})
+func UploadMedia(c messagebird.Requester, contentType string, r io.Reader) (*Media, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w: media is required", messagebird.ErrInvalidParams)
+	}
+
+	if contentType == "" {
+		// DetectContentType considers at most the first 512 bytes.
+		head := make([]byte, 512)
+		n, err := io.ReadFull(r, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, err
+		}
+		head = head[:n]
+		contentType = http.DetectContentType(head)
+		r = io.MultiReader(bytes.NewReader(head), r)
+	}
+	if err := validateMediaType(contentType); err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadAll(io.LimitReader(r, MaxMediaSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > MaxMediaSize {
+		return nil, fmt.Errorf("%w: media is larger than %d bytes", messagebird.ErrInvalidParams, MaxMediaSize)
+	}
+
+	media := &Media{}
+	if err := c.Request(media, http.MethodPost, mediaRoot, &messagebird.RawBody{
+		ContentType: contentType,
+		Body:        bytes.NewReader(content),
+	}); err != nil {
+		return nil, err
+	}
+	media.ContentType = contentType
+	media.Size = int64(len(content))
+
+	return media, nil
+}
+
+// validateMediaType returns an error if contentType, without parameters, is
+// not one of SupportedMediaTypes.
+func validateMediaType(contentType string) error {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%w: invalid media type %q: %v", messagebird.ErrInvalidParams, contentType, err)
+	}
+
+	for _, supported := range SupportedMediaTypes {
+		if strings.EqualFold(mediaType, supported) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: media type %q is not supported for MMS messages", messagebird.ErrInvalidParams, mediaType)
+}
@@ -0,0 +1,68 @@
+package mms
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// pngHeader is the signature of PNG files.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func TestUploadMedia(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"id":"5440b470-5c14-4d2b-9d8d-9e8e0d3c2b70"}`), http.StatusOK)
+	client := mbtest.Client(t)
+
+	media, err := UploadMedia(client, "image/png", bytes.NewReader(pngHeader))
+	assert.NoError(t, err)
+	assert.Equal(t, "5440b470-5c14-4d2b-9d8d-9e8e0d3c2b70", media.ID)
+	assert.Equal(t, "image/png", media.ContentType)
+	assert.Equal(t, int64(len(pngHeader)), media.Size)
+	assert.Equal(t, "https://messaging.messagebird.com/v1/files/5440b470-5c14-4d2b-9d8d-9e8e0d3c2b70", media.URL())
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/v1/files")
+	assert.Equal(t, "image/png", mbtest.Request.ContentType)
+	assert.Equal(t, pngHeader, mbtest.Request.Body)
+}
+
+func TestUploadMediaDetectContentType(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"id":"5440b470-5c14-4d2b-9d8d-9e8e0d3c2b70"}`), http.StatusOK)
+	client := mbtest.Client(t)
+
+	content := append(append([]byte{}, pngHeader...), make([]byte, 1024)...)
+	media, err := UploadMedia(client, "", bytes.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", media.ContentType)
+	assert.Equal(t, content, mbtest.Request.Body)
+}
+
+func TestUploadMediaUnsupported(t *testing.T) {
+	client := mbtest.Client(t)
+
+	_, err := UploadMedia(client, "", strings.NewReader("plain text"))
+	assert.EqualError(t, err, `invalid or missing parameters: media type "text/plain" is not supported for MMS messages`)
+
+	_, err = UploadMedia(client, "application/zip", strings.NewReader("PK"))
+	assert.ErrorIs(t, err, messagebird.ErrInvalidParams)
+
+	_, err = UploadMedia(client, "image/png", nil)
+	assert.EqualError(t, err, "invalid or missing parameters: media is required")
+}
+
+func TestValidateMediaType(t *testing.T) {
+	assert.NoError(t, validateMediaType("audio/L24; rate=8000"))
+	assert.NoError(t, validateMediaType("IMAGE/JPEG"))
+	assert.ErrorIs(t, validateMediaType("image"), messagebird.ErrInvalidParams)
+}
+
+func TestUploadMediaTooLarge(t *testing.T) {
+	client := mbtest.Client(t)
+
+	_, err := UploadMedia(client, "image/png", bytes.NewReader(make([]byte, MaxMediaSize+1)))
+	assert.EqualError(t, err, "invalid or missing parameters: media is larger than 1048576 bytes")
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,16 @@ type Message struct {
 	Recipients        messagebird.Recipients
 }
 
+// MessageList represents a list of MMS Messages.
+type MessageList struct {
+	Offset     int
+	Limit      int
+	Count      int
+	TotalCount int
+	Links      map[string]*string
+	Items      []Message
+}
+
 // Params represents the parameters that can be supplied when creating
 // a request.
 type Params struct {
@@ -35,11 +46,23 @@ type Params struct {
 	ScheduledDatetime time.Time
 }
 
+// ListParams can be used to filter the MMS messages returned by List.
+type ListParams struct {
+	Originator string
+	Direction  string
+	Status     string
+	Reference  string
+	From       time.Time // Only list messages created at or after From.
+	Until      time.Time // Only list messages created before Until.
+	Limit      int
+	Offset     int
+}
+
 // path represents the path to the MMS resource.
 const path = "mms"
 
 // Read retrieves the information of an existing MmsMessage.
-func Read(c *messagebird.Client, id string) (*Message, error) {
+func Read(c messagebird.Requester, id string) (*Message, error) {
 	mmsMessage := &Message{}
 	if err := c.Request(mmsMessage, http.MethodGet, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -48,8 +71,33 @@ func Read(c *messagebird.Client, id string) (*Message, error) {
 	return mmsMessage, nil
 }
 
+// Delete deletes the MMS message with the provided ID, e.g. to cancel sending a
+// scheduled message. If nil is returned, the message was deleted successfully.
+func Delete(c messagebird.Requester, id string) error {
+	if id == "" {
+		return errors.New("id is required")
+	}
+
+	return c.Request(nil, http.MethodDelete, path+"/"+id, nil)
+}
+
+// List retrieves the MMS messages of the user represented as a MessageList
+// object.
+func List(c messagebird.Requester, listParams *ListParams) (*MessageList, error) {
+	messageList := &MessageList{}
+	if err := c.Request(messageList, http.MethodGet, path+"?"+paramsForMessageList(listParams).Encode(), nil); err != nil {
+		return nil, err
+	}
+
+	return messageList, nil
+}
+
 // Create creates a new MMS message for one or more recipients.
-func Create(c *messagebird.Client, originator string, recipients []string, msgParams *Params) (*Message, error) {
+func Create(c messagebird.Requester, originator string, recipients []string, msgParams *Params) (*Message, error) {
+	if err := messagebird.ValidateOriginator(originator); err != nil {
+		return nil, err
+	}
+
 	params, err := paramsForMessage(msgParams)
 	if err != nil {
 		return nil, err
@@ -71,8 +119,8 @@ func Create(c *messagebird.Client, originator string, recipients []string, msgPa
 func paramsForMessage(params *Params) (*url.Values, error) {
 	urlParams := &url.Values{}
 
-	if params.Body == "" && params.MediaUrls == nil {
-		return nil, errors.New("Body or MediaUrls is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
 	if params.Body != "" {
 		urlParams.Set("body", params.Body)
@@ -92,3 +140,38 @@ func paramsForMessage(params *Params) (*url.Values, error) {
 
 	return urlParams, nil
 }
+
+// paramsForMessageList converts the specified ListParams struct to a
+// url.Values pointer and returns it.
+func paramsForMessageList(params *ListParams) *url.Values {
+	urlParams := &url.Values{}
+
+	if params == nil {
+		return urlParams
+	}
+
+	if params.Originator != "" {
+		urlParams.Set("originator", params.Originator)
+	}
+	if params.Direction != "" {
+		urlParams.Set("direction", params.Direction)
+	}
+	if params.Status != "" {
+		urlParams.Set("status", params.Status)
+	}
+	if params.Reference != "" {
+		urlParams.Set("reference", params.Reference)
+	}
+	if !params.From.IsZero() {
+		urlParams.Set("from", params.From.Format(time.RFC3339))
+	}
+	if !params.Until.IsZero() {
+		urlParams.Set("until", params.Until.Format(time.RFC3339))
+	}
+	if params.Limit != 0 {
+		urlParams.Set("limit", strconv.Itoa(params.Limit))
+	}
+	urlParams.Set("offset", strconv.Itoa(params.Offset))
+
+	return urlParams
+}
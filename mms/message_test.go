@@ -1,6 +1,7 @@
 package mms
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -82,5 +83,67 @@ func TestCreateWithEmptyParams(t *testing.T) {
 	}
 
 	_, err := Create(client, "TestName", []string{"31612345678"}, params)
-	assert.EqualError(t, err, "Body or MediaUrls is required")
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+	assert.EqualError(t, err, "invalid or missing parameters: Body or MediaUrls is required")
+}
+
+func TestList(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "mmsMessageListObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	list, err := List(client, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, list.Offset)
+	assert.Equal(t, 20, list.Limit)
+	assert.Equal(t, 1, list.Count)
+	assert.Equal(t, 1, list.TotalCount)
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, "6d9e7100b1f9406c81a3c303c30ccf05", list.Items[0].ID)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/mms")
+}
+
+func TestListWithParams(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "mmsMessageListObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := List(client, &ListParams{
+		Originator: "TestName",
+		Direction:  "mt",
+		Status:     "delivered",
+		Reference:  "TestReference",
+		From:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		Until:      time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		Limit:      50,
+		Offset:     100,
+	})
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/mms")
+	query := mbtest.Request.URL.Query()
+	assert.Equal(t, "TestName", query.Get("originator"))
+	assert.Equal(t, "mt", query.Get("direction"))
+	assert.Equal(t, "delivered", query.Get("status"))
+	assert.Equal(t, "TestReference", query.Get("reference"))
+	assert.Equal(t, "2021-03-01T00:00:00Z", query.Get("from"))
+	assert.Equal(t, "2021-04-01T00:00:00Z", query.Get("until"))
+	assert.Equal(t, "50", query.Get("limit"))
+	assert.Equal(t, "100", query.Get("offset"))
+}
+
+func TestDelete(t *testing.T) {
+	mbtest.WillReturn([]byte(""), http.StatusNoContent)
+	client := mbtest.Client(t)
+
+	err := Delete(client, "6d9e7100b1f9406c81a3c303c30ccf05")
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodDelete, "/mms/6d9e7100b1f9406c81a3c303c30ccf05")
+}
+
+func TestDeleteWithEmptyID(t *testing.T) {
+	client := mbtest.Client(t)
+
+	err := Delete(client, "")
+	assert.Error(t, err)
 }
@@ -0,0 +1,29 @@
+package mms
+
+import (
+	"net/http"
+
+	"github.com/messagebird/go-rest-api/v7/signature"
+	"github.com/messagebird/go-rest-api/v7/sms"
+)
+
+// Report is a status report of an MMS message to one recipient. MMS status
+// reports have the same parameters as SMS status reports, so they are parsed
+// into the same type, and a single handler can track the delivery of both.
+// Fields that only apply to SMS, like MessagePartCount, are left empty.
+type Report = sms.Report
+
+// ReportHandler is an http.Handler for status reports, that calls the
+// callback registered for the status of the report. See sms.ReportHandler.
+type ReportHandler = sms.ReportHandler
+
+// ParseReport parses and validates the status report in r.
+func ParseReport(r *http.Request) (*Report, error) {
+	return sms.ParseReport(r)
+}
+
+// NewReportHandler returns a ReportHandler that checks the signature of
+// requests with validator. If validator is nil, signatures are not checked.
+func NewReportHandler(validator *signature.Validator) *ReportHandler {
+	return sms.NewReportHandler(validator)
+}
@@ -0,0 +1,43 @@
+package mms
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+const testReportQuery = "id=6d9e7100b1f9406c81a3c303c30ccf05&reference=TestReference&recipient=31612345678&status=delivered&statusDatetime=2017-10-20T12%3A51%3A00%2B00%3A00"
+
+func TestParseReport(t *testing.T) {
+	report, err := ParseReport(httptest.NewRequest(http.MethodGet, "/reports?"+testReportQuery, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "6d9e7100b1f9406c81a3c303c30ccf05", report.ID)
+	assert.Equal(t, "TestReference", report.Reference)
+	assert.Equal(t, "31612345678", report.Recipient)
+	assert.True(t, report.IsDelivered())
+	assert.Equal(t, "2017-10-20T12:51:00Z", report.StatusDatetime.UTC().Format(time.RFC3339))
+
+	_, err = ParseReport(httptest.NewRequest(http.MethodGet, "/reports?id=6d9e7100b1f9406c81a3c303c30ccf05", nil))
+	assert.Error(t, err)
+}
+
+func TestReportHandler(t *testing.T) {
+	var delivered []*Report
+	h := NewReportHandler(nil)
+	h.Handle(messagebird.RecipientStatusDelivered, func(r *Report) { delivered = append(delivered, r) })
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports?"+testReportQuery, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports?status=delivered", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Len(t, delivered, 1)
+	assert.Equal(t, "6d9e7100b1f9406c81a3c303c30ccf05", delivered[0].ID)
+}
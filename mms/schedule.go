@@ -0,0 +1,45 @@
+package mms
+
+import (
+	"errors"
+	"fmt"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// StatusScheduled is the status of messages that are scheduled to be sent
+// later.
+const StatusScheduled = messagebird.RecipientStatusScheduled
+
+// ErrNotScheduled is returned by Cancel for messages that are not scheduled
+// (anymore), e.g. because they were already sent.
+var ErrNotScheduled = errors.New("message is not scheduled")
+
+// ListScheduled retrieves the MMS messages that are scheduled to be sent, i.e.
+// List with the StatusScheduled filter. params may be nil.
+func ListScheduled(c messagebird.Requester, params *ListParams) (*MessageList, error) {
+	scheduledParams := &ListParams{}
+	if params != nil {
+		*scheduledParams = *params
+	}
+	scheduledParams.Status = StatusScheduled
+
+	return List(c, scheduledParams)
+}
+
+// Cancel cancels sending the scheduled MMS message with the given ID. It
+// returns an error matching ErrNotScheduled if the message already left the
+// queue.
+func Cancel(c messagebird.Requester, id string) error {
+	message, err := Read(c, id)
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range message.Recipients.Items {
+		if recipient.Status == StatusScheduled {
+			return Delete(c, id)
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotScheduled, id)
+}
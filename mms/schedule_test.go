@@ -0,0 +1,57 @@
+package mms
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateScheduled(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "mmsMessageScheduledObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	message, err := Create(client, "TestName", []string{"31612345678"}, &Params{
+		Body:              "Hello World",
+		ScheduledDatetime: time.Date(2017, 10, 21, 9, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2017-10-21T09:00:00Z", message.ScheduledDatetime.Format(time.RFC3339))
+	assert.Equal(t, StatusScheduled, message.Recipients.Items[0].Status)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/mms")
+	assert.Contains(t, string(mbtest.Request.Body), "2017-10-21T09:00:00Z")
+}
+
+func TestListScheduled(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "mmsMessageListObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := ListScheduled(client, &ListParams{Status: "sent", Limit: 10})
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/mms")
+	query := mbtest.Request.URL.Query()
+	assert.Equal(t, "scheduled", query.Get("status"))
+	assert.Equal(t, "10", query.Get("limit"))
+}
+
+func TestCancel(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "mmsMessageScheduledObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	assert.NoError(t, Cancel(client, "6d9e7100b1f9406c81a3c303c30ccf05"))
+	mbtest.AssertEndpointCalled(t, http.MethodDelete, "/mms/6d9e7100b1f9406c81a3c303c30ccf05")
+}
+
+func TestCancelNotScheduled(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "mmsMessageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	err := Cancel(client, "6d9e7100b1f9406c81a3c303c30ccf05")
+	assert.True(t, errors.Is(err, ErrNotScheduled))
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/mms/6d9e7100b1f9406c81a3c303c30ccf05")
+}
@@ -0,0 +1,47 @@
+package mms
+
+import (
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// Limits of MMS messages, as enforced by the API.
+const (
+	MaxBodyLength    = 2000    // Maximum number of characters of the body.
+	MaxSubjectLength = 256     // Maximum number of characters of the subject.
+	MaxMediaURLs     = 10      // Maximum number of media attached to a message.
+	MaxMediaSize     = 1 << 20 // Maximum size of the media of a message, in bytes.
+)
+
+// Validate checks the params against the limits of MMS messages, so invalid
+// messages are rejected with a descriptive error before they reach the API.
+// Create calls it. Errors match messagebird.ErrInvalidParams.
+//
+// The size of media that is hosted elsewhere can't be checked; the size of
+// media uploaded with UploadMedia is.
+func (p *Params) Validate() error {
+	if p.Body == "" && len(p.MediaUrls) == 0 {
+		return fmt.Errorf("%w: Body or MediaUrls is required", messagebird.ErrInvalidParams)
+	}
+	if n := utf8.RuneCountInString(p.Body); n > MaxBodyLength {
+		return fmt.Errorf("%w: body has %d characters, at most %d are allowed", messagebird.ErrInvalidParams, n, MaxBodyLength)
+	}
+	if n := utf8.RuneCountInString(p.Subject); n > MaxSubjectLength {
+		return fmt.Errorf("%w: subject has %d characters, at most %d are allowed", messagebird.ErrInvalidParams, n, MaxSubjectLength)
+	}
+
+	if len(p.MediaUrls) > MaxMediaURLs {
+		return fmt.Errorf("%w: %d media URLs given, at most %d are allowed", messagebird.ErrInvalidParams, len(p.MediaUrls), MaxMediaURLs)
+	}
+	for _, mediaURL := range p.MediaUrls {
+		u, err := url.Parse(mediaURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: media URL %q must be an absolute http or https URL", messagebird.ErrInvalidParams, mediaURL)
+		}
+	}
+
+	return nil
+}
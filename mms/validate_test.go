@@ -0,0 +1,41 @@
+package mms
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	params := &Params{
+		Body:      strings.Repeat("é", MaxBodyLength),
+		Subject:   strings.Repeat("a", MaxSubjectLength),
+		MediaUrls: make([]string, MaxMediaURLs),
+	}
+	for i := range params.MediaUrls {
+		params.MediaUrls[i] = "https://example.com/image.png"
+	}
+	assert.NoError(t, params.Validate())
+}
+
+func TestParamsValidateInvalid(t *testing.T) {
+	tt := []struct {
+		name   string
+		params *Params
+	}{
+		{"no body or media", &Params{}},
+		{"empty media", &Params{MediaUrls: []string{}}},
+		{"long body", &Params{Body: strings.Repeat("a", MaxBodyLength+1)}},
+		{"long subject", &Params{Body: "Hello World", Subject: strings.Repeat("a", MaxSubjectLength+1)}},
+		{"too many media", &Params{MediaUrls: make([]string, MaxMediaURLs+1)}},
+		{"relative media URL", &Params{MediaUrls: []string{"/image.png"}}},
+	}
+
+	for _, tc := range tt {
+		err := tc.params.Validate()
+		assert.True(t, errors.Is(err, messagebird.ErrInvalidParams), tc.name)
+	}
+}
@@ -92,12 +92,12 @@ const (
 // request does the exact same thing as Client.Request. It does, however,
 // prefix the path with the Numbers API's root. This ensures the client
 // doesn't "handle" this for us: by default, it uses the REST API.
-func request(c *messagebird.Client, v interface{}, method, path string, data interface{}) error {
+func request(c messagebird.Requester, v interface{}, method, path string, data interface{}) error {
 	return c.Request(v, method, fmt.Sprintf("%s/%s", apiRoot, path), data)
 }
 
 // List get all purchased phone numbers
-func List(c *messagebird.Client, listParams *NumberListParams) (*NumberList, error) {
+func List(c messagebird.Requester, listParams *NumberListParams) (*NumberList, error) {
 	uri := getpath(listParams, pathNumbers)
 
 	numberList := &NumberList{}
@@ -108,7 +108,7 @@ func List(c *messagebird.Client, listParams *NumberListParams) (*NumberList, err
 }
 
 // Search for phone numbers available for purchase, countryCode needs to be in Alpha-2 country code (example: NL)
-func Search(c *messagebird.Client, countryCode string, listParams *NumberListParams) (*NumberSearchingList, error) {
+func Search(c messagebird.Requester, countryCode string, listParams *NumberListParams) (*NumberSearchingList, error) {
 	uri := getpath(listParams, pathNumbersAvailable+"/"+countryCode)
 
 	numberList := &NumberSearchingList{}
@@ -120,7 +120,7 @@ func Search(c *messagebird.Client, countryCode string, listParams *NumberListPar
 }
 
 // Read get a purchased phone number
-func Read(c *messagebird.Client, phoneNumber string) (*Number, error) {
+func Read(c messagebird.Requester, phoneNumber string) (*Number, error) {
 	if len(phoneNumber) < 5 {
 		return nil, fmt.Errorf("a phoneNumber is too short")
 	}
@@ -136,14 +136,14 @@ func Read(c *messagebird.Client, phoneNumber string) (*Number, error) {
 }
 
 // Delete a purchased phone number
-func Delete(c *messagebird.Client, phoneNumber string) error {
+func Delete(c messagebird.Requester, phoneNumber string) error {
 	uri := fmt.Sprintf("%s/%s", pathNumbers, phoneNumber)
 	return request(c, nil, http.MethodDelete, uri, nil)
 }
 
 // Update updates a purchased phone number.
 // Only updating *tags* is supported at the moment.
-func Update(c *messagebird.Client, phoneNumber string, numberUpdateRequest *NumberUpdateRequest) (*Number, error) {
+func Update(c messagebird.Requester, phoneNumber string, numberUpdateRequest *NumberUpdateRequest) (*Number, error) {
 	uri := fmt.Sprintf("%s/%s", pathNumbers, phoneNumber)
 
 	number := &Number{}
@@ -155,7 +155,7 @@ func Update(c *messagebird.Client, phoneNumber string, numberUpdateRequest *Numb
 }
 
 // Purchases purchases a phone number.
-func Purchase(c *messagebird.Client, numberPurchaseRequest *NumberPurchaseRequest) (*Number, error) {
+func Purchase(c messagebird.Requester, numberPurchaseRequest *NumberPurchaseRequest) (*Number, error) {
 
 	number := &Number{}
 	if err := request(c, number, http.MethodPost, pathNumbers, numberPurchaseRequest); err != nil {
@@ -0,0 +1,67 @@
+package messagebird
+
+import "fmt"
+
+// Limits of originators, i.e. the senders of messages.
+const (
+	// MaxAlphanumericOriginatorLength is the maximum length of an
+	// alphanumeric sender ID.
+	MaxAlphanumericOriginatorLength = 11
+
+	// MaxNumericOriginatorLength is the maximum number of digits of a phone
+	// number in E.164 format.
+	MaxNumericOriginatorLength = 15
+)
+
+// ValidateOriginator checks that originator is a valid phone number in E.164
+// format, with or without leading "+", or an alphanumeric sender ID of at most
+// 11 letters, digits and spaces. The returned error matches ErrInvalidParams.
+//
+// Whether alphanumeric sender IDs are accepted depends on the destination
+// country; ValidateOriginator only checks the format.
+func ValidateOriginator(originator string) error {
+	if originator == "" {
+		return fmt.Errorf("%w: originator is required", ErrInvalidParams)
+	}
+
+	number := originator
+	if number[0] == '+' {
+		number = number[1:]
+	}
+	if isDigits(number) {
+		if number[0] == '0' {
+			return fmt.Errorf("%w: originator %q must not start with 0; use the international format, e.g. 31612345678", ErrInvalidParams, originator)
+		}
+		if len(number) > MaxNumericOriginatorLength {
+			return fmt.Errorf("%w: numeric originator %q must be at most %d digits", ErrInvalidParams, originator, MaxNumericOriginatorLength)
+		}
+		return nil
+	}
+	if originator[0] == '+' {
+		return fmt.Errorf("%w: originator %q must contain only digits after the +", ErrInvalidParams, originator)
+	}
+
+	if len(originator) > MaxAlphanumericOriginatorLength {
+		return fmt.Errorf("%w: alphanumeric originator %q must be at most %d characters", ErrInvalidParams, originator, MaxAlphanumericOriginatorLength)
+	}
+	for _, r := range originator {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == ' ') {
+			return fmt.Errorf("%w: alphanumeric originator %q may only contain letters, digits and spaces", ErrInvalidParams, originator)
+		}
+	}
+
+	return nil
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
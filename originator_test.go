@@ -0,0 +1,39 @@
+package messagebird
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOriginator(t *testing.T) {
+	tt := []struct {
+		originator string
+		wantErr    string
+	}{
+		{"MSGBIRD", ""},
+		{"Message Bird", "alphanumeric originator \"Message Bird\" must be at most 11 characters"},
+		{"MessageBird", ""},
+		{"31612345678", ""},
+		{"+31612345678", ""},
+		{"1234", ""},
+		{"", "originator is required"},
+		{"0612345678", "originator \"0612345678\" must not start with 0; use the international format, e.g. 31612345678"},
+		{"+3161234567890123", "numeric originator \"+3161234567890123\" must be at most 15 digits"},
+		{"+31 612345678", "originator \"+31 612345678\" must contain only digits after the +"},
+		{"MSG-BIRD", "alphanumeric originator \"MSG-BIRD\" may only contain letters, digits and spaces"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.originator, func(t *testing.T) {
+			err := ValidateOriginator(tc.originator)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, "invalid or missing parameters: "+tc.wantErr)
+			assert.True(t, errors.Is(err, ErrInvalidParams))
+		})
+	}
+}
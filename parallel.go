@@ -0,0 +1,117 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultConcurrency is the number of concurrent calls Parallel makes when no
+// positive concurrency is given.
+const DefaultConcurrency = 10
+
+// ParallelError is returned by Parallel when some of the calls failed.
+type ParallelError struct {
+	// Errors has the error of each call, in the order of the inputs. It is
+	// nil for calls that succeeded.
+	Errors []error
+}
+
+// Error implements the error interface.
+func (e *ParallelError) Error() string {
+	var failed int
+	var first error
+	for _, err := range e.Errors {
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			failed++
+		}
+	}
+	return fmt.Sprintf("%d of %d calls failed, first error: %v", failed, len(e.Errors), first)
+}
+
+// Is reports whether the error of any call matches target. It makes errors.Is
+// look at the errors of the calls on Go versions before 1.20, which don't
+// support Unwrap returning multiple errors.
+func (e *ParallelError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if err != nil && errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error of a call that matches target, like Is.
+func (e *ParallelError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if err != nil && errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the errors of the calls that failed.
+func (e *ParallelError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errors {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Parallel calls fn for every input, making up to concurrency calls at the
+// same time, and returns the outputs in the order of the inputs. If any of the
+// calls fail, the outputs of the successful calls are returned along with a
+// *ParallelError. When ctx is done, no more calls are started and the
+// remaining inputs fail with ctx's error.
+//
+// For example, to read many verifications:
+//
+//	verifies, err := messagebird.Parallel(ctx, ids, 20, func(ctx context.Context, id string) (*verify.Verify, error) {
+//		return verify.Read(client.WithContext(ctx), id)
+//	})
+func Parallel[In, Out any](ctx context.Context, inputs []In, concurrency int, fn func(context.Context, In) (Out, error)) ([]Out, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	outputs := make([]Out, len(inputs))
+	errs := make([]error, len(inputs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, input := range inputs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(inputs); j++ {
+				errs[j] = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, input In) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			outputs[i], errs[i] = fn(ctx, input)
+		}(i, input)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return outputs, &ParallelError{Errors: errs}
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,81 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallel(t *testing.T) {
+	var running, maxRunning int32
+	outputs, err := Parallel(context.Background(), []int{1, 2, 3, 4, 5, 6}, 2, func(ctx context.Context, i int) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		return i * 10, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{10, 20, 30, 40, 50, 60}, outputs)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestParallelErrors(t *testing.T) {
+	outputs, err := Parallel(context.Background(), []string{"a", "missing", "b"}, 0, func(ctx context.Context, id string) (string, error) {
+		if id == "missing" {
+			return "", ErrorResponse{Errors: []Error{{Code: ErrorCodeNotFound, Description: "not found"}}}
+		}
+		return id + "!", nil
+	})
+	assert.Equal(t, []string{"a!", "", "b!"}, outputs)
+
+	var parallelErr *ParallelError
+	assert.True(t, errors.As(err, &parallelErr))
+	assert.Nil(t, parallelErr.Errors[0])
+	assert.True(t, errors.Is(parallelErr.Errors[1], ErrNotFound))
+	assert.Len(t, parallelErr.Unwrap(), 1)
+	assert.Equal(t, "1 of 3 calls failed, first error: API errors: not found", err.Error())
+}
+
+func TestParallelErrorIsAs(t *testing.T) {
+	err := &ParallelError{Errors: []error{
+		nil,
+		fmt.Errorf("reading: %w", ErrorResponse{Errors: []Error{{Code: ErrorCodeNotFound, Description: "not found"}}}),
+	}}
+
+	// Is and As are called directly, as errors.Is and errors.As would use
+	// Unwrap on Go 1.20 and later.
+	assert.True(t, err.Is(ErrNotFound))
+	assert.False(t, err.Is(ErrUnauthorized))
+
+	var errResp ErrorResponse
+	assert.True(t, err.As(&errResp))
+	assert.Equal(t, ErrorCodeNotFound, errResp.Errors[0].Code)
+
+	var rateLimitErr *RateLimitError
+	assert.False(t, err.As(&rateLimitErr))
+}
+
+func TestParallelCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	_, err := Parallel(ctx, []int{1, 2, 3, 4}, 1, func(ctx context.Context, i int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		cancel()
+		return i, nil
+	})
+
+	var parallelErr *ParallelError
+	assert.True(t, errors.As(err, &parallelErr))
+	assert.Nil(t, parallelErr.Errors[0])
+	assert.Equal(t, context.Canceled, parallelErr.Errors[3])
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,85 @@
+package messagebird
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit describes the rate limit state reported by the API through
+// response headers. Fields are zero when the corresponding header is absent.
+type RateLimit struct {
+	Limit     int       // Maximum number of requests allowed in the current window.
+	Remaining int       // Number of requests left in the current window.
+	Reset     time.Time // Time at which the current window resets.
+}
+
+// RateLimitError is returned when the API responds with 429 Too Many Requests.
+type RateLimitError struct {
+	RateLimit
+
+	// RetryAfter is the minimum time to wait before sending a new request, as
+	// indicated by the Retry-After header. It is zero if the header is absent.
+	RetryAfter time.Duration
+
+	// Err is the error as returned by the API, typically an ErrorResponse.
+	Err error
+}
+
+// Error implements error interface.
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited, retry after %s: %v", e.RetryAfter, e.Err)
+	}
+	return fmt.Sprintf("rate limited: %v", e.Err)
+}
+
+// Unwrap returns the error as returned by the API.
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// ResetTime returns the time at which a new request may be sent. It prefers
+// the Retry-After header and falls back to the rate limit reset time.
+func (e *RateLimitError) ResetTime(now time.Time) time.Time {
+	if e.RetryAfter > 0 {
+		return now.Add(e.RetryAfter)
+	}
+	return e.Reset
+}
+
+func newRateLimitError(h http.Header, err error) *RateLimitError {
+	return &RateLimitError{
+		RateLimit:  parseRateLimit(h),
+		RetryAfter: parseRetryAfter(h, time.Now()),
+		Err:        err,
+	}
+}
+
+// parseRateLimit reads the X-RateLimit-* headers.
+func parseRateLimit(h http.Header) RateLimit {
+	var rl RateLimit
+	rl.Limit, _ = strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	rl.Remaining, _ = strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	return rl
+}
+
+// parseRetryAfter reads the Retry-After header, which is either a number of
+// seconds or an HTTP date. It returns zero if the header is absent or invalid.
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
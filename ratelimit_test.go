@@ -0,0 +1,72 @@
+package messagebird
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rateLimitedBody = `{"errors":[{"code":429,"description":"Too many requests","parameter":null}]}`
+
+func TestRateLimitError(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.Header().Set("X-RateLimit-Limit", "500")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "1600000000")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(rateLimitedBody))
+	})
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+
+	var rlErr *RateLimitError
+	assert.True(t, errors.As(err, &rlErr))
+	assert.Equal(t, 30*time.Second, rlErr.RetryAfter)
+	assert.Equal(t, 500, rlErr.Limit)
+	assert.Equal(t, 0, rlErr.Remaining)
+	assert.Equal(t, time.Unix(1600000000, 0), rlErr.Reset)
+
+	var errRes ErrorResponse
+	assert.True(t, errors.As(err, &errRes))
+	assert.Equal(t, "Too many requests", errRes.Errors[0].Description)
+}
+
+func TestRateLimitRetry(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(rateLimitedBody))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MaxRateLimitWait: 2 * time.Second}
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	h := http.Header{}
+	assert.Equal(t, time.Duration(0), parseRetryAfter(h, now))
+
+	h.Set("Retry-After", "120")
+	assert.Equal(t, 2*time.Minute, parseRetryAfter(h, now))
+
+	h.Set("Retry-After", now.Add(time.Minute).Format(http.TimeFormat))
+	assert.Equal(t, time.Minute, parseRetryAfter(h, now))
+
+	h.Set("Retry-After", "soon")
+	assert.Equal(t, time.Duration(0), parseRetryAfter(h, now))
+}
@@ -0,0 +1,84 @@
+package messagebird
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// A RateLimiter limits the rate at which a client sends requests, using a
+// token bucket: the bucket holds up to burst tokens, is refilled at a rate of
+// requestsPerSecond and every request takes a token, waiting for one if the
+// bucket is empty. Share a RateLimiter between clients that use the same
+// account to stay below the account's rate limits.
+//
+// Every attempt of a retried request takes a token. A RateLimiter is safe for
+// concurrent use.
+type RateLimiter struct {
+	rate  float64 // Tokens added per second.
+	burst float64 // Maximum number of tokens.
+
+	mu     sync.Mutex
+	tokens float64   // Available tokens; negative when waiters reserved them.
+	last   time.Time // When tokens was last updated.
+}
+
+// NewRateLimiter returns a RateLimiter that allows requestsPerSecond requests
+// per second on average, and bursts of up to burst requests. Burst values
+// lower than 1 are treated as 1. NewRateLimiter panics if requestsPerSecond is
+// not positive.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if !(requestsPerSecond > 0) || math.IsInf(requestsPerSecond, 1) {
+		panic("messagebird: non-positive or infinite rate for NewRateLimiter")
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent, or until ctx is done. It returns
+// ctx's error in the latter case.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	if err := Sleep(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token and returns how long to wait before it can be used.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was not used.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
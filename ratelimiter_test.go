@@ -0,0 +1,56 @@
+package messagebird
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(100, 2)
+
+	// The burst is available immediately.
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	// Then tokens are added every 10ms.
+	d := l.reserve()
+	assert.InDelta(t, float64(10*time.Millisecond), float64(d), float64(time.Millisecond))
+	d = l.reserve()
+	assert.InDelta(t, float64(20*time.Millisecond), float64(d), float64(time.Millisecond))
+}
+
+func TestNewRateLimiterInvalidRate(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		assert.Panics(t, func() { NewRateLimiter(rate, 1) }, "%v", rate)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+
+	// The canceled wait gave its token back.
+	assert.InDelta(t, float64(time.Second), float64(l.reserve()), float64(50*time.Millisecond))
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client.RateLimiter = NewRateLimiter(50, 1)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	}
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(35*time.Millisecond))
+}
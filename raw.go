@@ -0,0 +1,80 @@
+package messagebird
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// NewRequest returns a request for an API endpoint, e.g. one that is not yet
+// covered by the resource packages. path is either absolute or relative to
+// Endpoint and data is encoded as by Request: strings are sent as form data,
+// RawBody and MultipartBody as described by their types, and anything else as
+// JSON.
+//
+// Send the request with Do to get the raw response, and use DecodeResponse to
+// decode it:
+//
+//	req, err := client.NewRequest(ctx, http.MethodGet, "files/some-id", nil)
+//	resp, err := client.Do(req)
+//	log.Println(resp.Header.Get(messagebird.RequestIDHeader))
+//	var v File
+//	err = messagebird.DecodeResponse(resp, &v)
+//
+// Unlike Request, requests sent this way are not retried, rate limited or
+// recorded by Stats.
+func (c *Client) NewRequest(ctx context.Context, method, path string, data interface{}) (*http.Request, error) {
+	uri, err := requestURL(path)
+	if err != nil {
+		return nil, err
+	}
+
+	body, contentType, err := prepareRequestBody(data)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := c.requestHeader(ctx, method, contentType)
+	if err != nil {
+		return nil, err
+	}
+	// Do returns the response body as is, so leave compression to the
+	// transport, which decompresses responses it asked to be compressed.
+	header.Del("Accept-Encoding")
+
+	req, err := http.NewRequestWithContext(ctx, method, uri.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header
+	return req, nil
+}
+
+// DecodeResponse reads and closes the body of resp, a response to a request
+// sent with Do, and decodes it into v. Errors are decoded as by Request, so an
+// unsuccessful response results in e.g. an ErrorResponse. Unknown fields are
+// ignored, regardless of the client's StrictDecoding setting.
+func DecodeResponse(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(v, originalURL(resp.Request), resp, body, false)
+}
+
+// originalURL returns the URL of req before Do rewrote it according to
+// BaseURL and APIBaseURLs. It returns nil if req is nil, e.g. for responses
+// that were not received by an http.Client.
+func originalURL(req *http.Request) *url.URL {
+	if req == nil {
+		return nil
+	}
+	if u, ok := req.Context().Value(originalURLContextKey).(*url.URL); ok {
+		return u
+	}
+	return req.URL
+}
@@ -0,0 +1,110 @@
+package messagebird
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequest(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "AccessKey test_gshuPaZoeEG6ovbc8M79w0QyM", r.Header.Get("Authorization"))
+
+		w.Header().Set(RequestIDHeader, "request-id")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"code":20,"description":"not found","parameter":null}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"file-id"}`))
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodPost, srv.URL+"/files", map[string]string{"name": "file"})
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "request-id", resp.Header.Get(RequestIDHeader))
+
+	var v struct{ ID string }
+	assert.NoError(t, DecodeResponse(resp, &v))
+	assert.Equal(t, "file-id", v.ID)
+
+	req, err = client.NewRequest(context.Background(), http.MethodPost, srv.URL+"/missing", map[string]string{})
+	assert.NoError(t, err)
+	resp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(DecodeResponse(resp, &v), ErrNotFound))
+}
+
+func TestNewRequestRelativePath(t *testing.T) {
+	client := New("test_gshuPaZoeEG6ovbc8M79w0QyM")
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "balance", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Endpoint+"/balance", req.URL.String())
+	assert.Equal(t, "application/json", req.Header.Get("Accept"))
+}
+
+func TestDecodeResponseWithoutRequest(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"errors":[{"code":20,"description":"not found","parameter":null}]}`)),
+	}
+	assert.True(t, errors.Is(DecodeResponse(resp, nil), ErrNotFound))
+}
+
+func TestDecodeResponseVoiceBaseURL(t *testing.T) {
+	errVoice := errors.New("voice error")
+	defer SetVoiceErrorReader(voiceErrorReader)
+	SetVoiceErrorReader(func([]byte) error { return errVoice })
+
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/voice/calls", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":13,"message":"not found"}]}`))
+	})
+	client.APIBaseURLs = map[string]string{voiceHost: srv.URL + "/voice"}
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "https://voice.messagebird.com/calls", nil)
+	assert.NoError(t, err)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://voice.messagebird.com/calls", req.URL.String())
+	assert.Equal(t, errVoice, DecodeResponse(resp, nil))
+}
+
+func TestDoGzipResponse(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.Write([]byte(`{"id":"file-id"}`))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gw := gzip.NewWriter(w)
+		gw.Write([]byte(`{"id":"file-id"}`))
+		gw.Close()
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, srv.URL+"/files/file-id", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, req.Header.Get("Accept-Encoding"))
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"file-id"}`, string(body))
+}
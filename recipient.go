@@ -1,12 +1,71 @@
 package messagebird
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
+
+// Statuses of a Recipient of an SMS, MMS or voice message.
+const (
+	RecipientStatusScheduled      = "scheduled"       // The message is scheduled to be sent later.
+	RecipientStatusSent           = "sent"            // The message was sent to the network.
+	RecipientStatusBuffered       = "buffered"        // The network is holding the message, e.g. because the handset is off.
+	RecipientStatusDelivered      = "delivered"       // The message was delivered to the recipient.
+	RecipientStatusExpired        = "expired"         // The message could not be delivered within its validity.
+	RecipientStatusDeliveryFailed = "delivery_failed" // The message could not be delivered.
+)
+
+// Directions of a message.
+const (
+	DirectionMT = "mt" // Mobile terminated: sent to a recipient.
+	DirectionMO = "mo" // Mobile originated: received from a sender.
+)
 
 // Recipient struct holds information for a single msisdn with status details.
 type Recipient struct {
 	Recipient      int64
 	Status         string
 	StatusDatetime *time.Time
+
+	// StatusReason and StatusErrorCode explain the status, e.g. why the
+	// message could not be delivered. StatusErrorCode is nil if the network
+	// didn't report an error.
+	StatusReason    string
+	StatusErrorCode *int
+
+	RecipientCountry       string
+	RecipientCountryPrefix int
+	RecipientOperator      string
+	MCCMNC                 string // Mobile country and network code of the recipient.
+	MessageLength          int
+	MessagePartCount       int
+
+	// Price is the price of the message for this recipient. It is nil if
+	// the message wasn't priced yet.
+	Price *Price
+}
+
+// Price is the price of a message.
+type Price struct {
+	// Amount is the exact price as returned by the API, e.g. "0.07".
+	Amount   json.Number
+	Currency string
+}
+
+// IsDelivered reports whether the message was delivered to the recipient.
+func (r Recipient) IsDelivered() bool {
+	return r.Status == RecipientStatusDelivered
+}
+
+// IsFailed reports whether the message could not be delivered to the
+// recipient.
+func (r Recipient) IsFailed() bool {
+	return r.Status == RecipientStatusDeliveryFailed || r.Status == RecipientStatusExpired
+}
+
+// IsFinal reports whether the recipient's status will not change anymore.
+func (r Recipient) IsFinal() bool {
+	return r.IsDelivered() || r.IsFailed()
 }
 
 // Recipients holds a collection of Recepient structs along with send stats.
@@ -0,0 +1,28 @@
+package messagebird
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecipientStatus(t *testing.T) {
+	tt := []struct {
+		status                   string
+		delivered, failed, final bool
+	}{
+		{RecipientStatusScheduled, false, false, false},
+		{RecipientStatusSent, false, false, false},
+		{RecipientStatusBuffered, false, false, false},
+		{RecipientStatusDelivered, true, false, true},
+		{RecipientStatusExpired, false, true, true},
+		{RecipientStatusDeliveryFailed, false, true, true},
+	}
+
+	for _, tc := range tt {
+		r := Recipient{Status: tc.status}
+		assert.Equal(t, tc.delivered, r.IsDelivered(), tc.status)
+		assert.Equal(t, tc.failed, r.IsFailed(), tc.status)
+		assert.Equal(t, tc.final, r.IsFinal(), tc.status)
+	}
+}
@@ -0,0 +1,33 @@
+package messagebird
+
+import "context"
+
+// Requester is the interface the resource packages use to make API requests.
+// *Client implements it. As all packages accept a Requester rather than a
+// *Client, the client can be replaced with a fake in tests.
+type Requester interface {
+	Request(v interface{}, method, path string, data interface{}) error
+}
+
+// Ensure *Client implements Requester.
+var _ Requester = (*Client)(nil)
+
+// ContextRequester is a Requester that can bind requests to a context.
+// *Client implements it.
+type ContextRequester interface {
+	Requester
+	RequestContext(ctx context.Context, v interface{}, method, path string, data interface{}) error
+}
+
+// Ensure *Client implements ContextRequester.
+var _ ContextRequester = (*Client)(nil)
+
+// RequestContext makes a request using c, bound to ctx if c is a
+// ContextRequester. Other Requesters, e.g. fakes in tests, make the request
+// without ctx.
+func RequestContext(ctx context.Context, c Requester, v interface{}, method, path string, data interface{}) error {
+	if cr, ok := c.(ContextRequester); ok {
+		return cr.RequestContext(ctx, v, method, path, data)
+	}
+	return c.Request(v, method, path, data)
+}
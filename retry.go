@@ -0,0 +1,209 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how a Client retries requests that failed due to
+// transient errors. Network errors are always considered transient; responses
+// are retried when their status code is listed in RetryableStatusCodes.
+// Errors of the client's Credentials are returned without retrying.
+//
+// Note that retrying a non-idempotent request (e.g. creating a message) after
+// a network error may result in the request being processed twice.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the initial
+	// request. Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the time to wait before the first retry. The wait time
+	// doubles for every subsequent retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the time to wait between two attempts. Zero means no
+	// cap.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes lists the HTTP status codes that should be retried.
+	RetryableStatusCodes []int
+
+	// MaxRateLimitWait enables retrying rate limited (429) requests. When the
+	// API asks to retry after no longer than MaxRateLimitWait, the client
+	// sleeps for that duration and tries again. Zero disables this.
+	MaxRateLimitWait time.Duration
+
+	// AttemptTimeout optionally limits the duration of a single attempt. An
+	// attempt that times out is retried like a network error. Use WithTimeout
+	// to limit the duration of a request as a whole.
+	AttemptTimeout time.Duration
+
+	// Jitter enables "full jitter": instead of waiting the full backoff,
+	// a random duration between zero and the backoff is waited. This spreads
+	// out the retries of many clients that failed at the same time.
+	Jitter bool
+
+	// Budget optionally limits the number of retries in a time window, so
+	// retries don't amplify an outage of the API. Requests that exceed the
+	// budget fail with the result of their last attempt. A policy's budget is
+	// shared by all clients using the policy.
+	Budget *RetryBudget
+}
+
+// A RetryBudget limits the number of retries in a sliding time window. It is
+// safe for concurrent use.
+type RetryBudget struct {
+	max    int
+	window time.Duration
+
+	mu      sync.Mutex
+	retries []time.Time // Times of the retries in the current window.
+}
+
+// NewRetryBudget returns a RetryBudget that allows up to maxRetries retries per
+// window.
+func NewRetryBudget(maxRetries int, window time.Duration) *RetryBudget {
+	return &RetryBudget{max: maxRetries, window: window}
+}
+
+// take reports whether a retry is within the budget, and records it if so.
+func (b *RetryBudget) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	i := 0
+	for i < len(b.retries) && now.Sub(b.retries[i]) >= b.window {
+		i++
+	}
+	b.retries = b.retries[i:]
+
+	if len(b.retries) >= b.max {
+		return false
+	}
+	b.retries = append(b.retries, now)
+	return true
+}
+
+var (
+	// jitterRand is seeded separately from the global source, so processes
+	// that start at the same time don't retry at the same time.
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	jitterMu   sync.Mutex
+)
+
+// DefaultRetryPolicy returns a RetryPolicy that retries network errors and
+// 5xx responses up to 3 attempts, waiting 100ms and 200ms in between.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  100 * time.Millisecond,
+		MaxBackoff:  2 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// retryDelay reports whether the request should be attempted again, given the
+// outcome of the previous attempt, and how long to wait before doing so.
+func (c *Client) retryDelay(ctx context.Context, attempt int, response *http.Response, err error) (time.Duration, bool) {
+	p := c.RetryPolicy
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	delay, retry := p.retryDelay(attempt, response, err)
+	if retry && p.Budget != nil && !p.Budget.take() {
+		return 0, false
+	}
+	return delay, retry
+}
+
+// retryDelay is like Client.retryDelay, but doesn't check the attempt or
+// budget limits.
+func (p *RetryPolicy) retryDelay(attempt int, response *http.Response, err error) (time.Duration, bool) {
+	if err != nil {
+		var credsErr *credentialsError
+		if errors.As(err, &credsErr) {
+			// A missing or revoked access key won't reappear by retrying.
+			return 0, false
+		}
+		// Errors caused by ctx being done were caught by the caller, so this
+		// is a network error or a timed out attempt.
+		return p.jitter(p.backoff(attempt)), true
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests && p.MaxRateLimitWait > 0 {
+		retryAfter := parseRetryAfter(response.Header, time.Now())
+		if retryAfter == 0 {
+			// The API did not say when to retry, fall back to regular backoff.
+			return p.jitter(p.backoff(attempt)), true
+		}
+		if retryAfter <= p.MaxRateLimitWait {
+			return retryAfter, true
+		}
+	}
+	if p.isRetryableStatus(response.StatusCode) {
+		return p.jitter(p.backoff(attempt)), true
+	}
+	return 0, false
+}
+
+// jitter returns a random duration between 0 and d if Jitter is enabled, and
+// d otherwise.
+func (p *RetryPolicy) jitter(d time.Duration) time.Duration {
+	if !p.Jitter || d <= 0 {
+		return d
+	}
+	jitterMu.Lock()
+	defer jitterMu.Unlock()
+	return time.Duration(jitterRand.Int63n(int64(d) + 1))
+}
+
+// isRetryableStatus reports whether statusCode is listed in
+// RetryableStatusCodes.
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the time to wait after the given attempt failed.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return d
+}
+
+// Sleep blocks for d, or until ctx is done. It returns ctx's error in the
+// latter case.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
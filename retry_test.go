@@ -0,0 +1,128 @@
+package messagebird
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"amount":9}`))
+	})
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.MinBackoff = time.Millisecond
+
+	var v struct{ Amount int }
+	err := client.Request(&v, http.MethodGet, srv.URL+"/balance", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, v.Amount)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicyMaxAttempts(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.RetryPolicy = &RetryPolicy{
+		MaxAttempts:          2,
+		MinBackoff:           time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusInternalServerError},
+	}
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/balance", nil)
+	assert.Equal(t, ErrUnexpectedResponse, err)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(3))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(10))
+}
+
+func TestRetryPolicyAttemptTimeout(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"amount":9}`))
+	})
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.MinBackoff = time.Millisecond
+	client.RetryPolicy.AttemptTimeout = 20 * time.Millisecond
+
+	var v struct{ Amount int }
+	err := client.Request(&v, http.MethodGet, srv.URL+"/balance", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, v.Amount)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, Jitter: true}
+
+	for i := 0; i < 100; i++ {
+		d := p.jitter(p.backoff(1))
+		assert.True(t, d >= 0 && d <= 100*time.Millisecond, "unexpected jitter %s", d)
+	}
+
+	p.Jitter = false
+	assert.Equal(t, 100*time.Millisecond, p.jitter(p.backoff(1)))
+}
+
+func TestRetryBudget(t *testing.T) {
+	var calls int32
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.MinBackoff = time.Millisecond
+	client.RetryPolicy.Budget = NewRetryBudget(3, time.Hour)
+
+	// The first request is retried twice, the second once, after which the
+	// budget is exhausted.
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, ErrUnexpectedResponse, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	}
+	assert.EqualValues(t, 3+2+1, atomic.LoadInt32(&calls))
+}
+
+func TestRetryBudgetWindow(t *testing.T) {
+	b := NewRetryBudget(1, 20*time.Millisecond)
+	assert.True(t, b.take())
+	assert.False(t, b.take())
+
+	time.Sleep(25 * time.Millisecond)
+	assert.True(t, b.take())
+}
+
+func TestSleep(t *testing.T) {
+	assert.NoError(t, Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, Sleep(ctx, time.Hour))
+}
@@ -0,0 +1,110 @@
+package sms
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// Sizes of binary messages, in bytes.
+const (
+	// MaxBinaryPartSize is the maximum size of a binary message, UDH
+	// included.
+	MaxBinaryPartSize = 140
+
+	// concatUDHSize is the size of the UDH built by ConcatUDH.
+	concatUDHSize = 6
+)
+
+// BinaryPart is one part of a binary message: its user data header (UDH) and
+// payload.
+type BinaryPart struct {
+	UDH  []byte
+	Data []byte
+}
+
+// ConcatUDH returns the user data header of part seq (starting at 1) of a
+// concatenated message consisting of total parts. ref identifies the message the
+// part belongs to, and must be the same for all of its parts.
+func ConcatUDH(ref byte, total, seq int) []byte {
+	// Information element 0x00 (concatenated message, 8-bit reference) of
+	// length 3.
+	return []byte{0x05, 0x00, 0x03, ref, byte(total), byte(seq)}
+}
+
+// SplitBinary splits payload into parts that fit a single binary message
+// each. A payload that fits one message is returned as a single part without
+// UDH. Otherwise, every part gets a concatenation UDH with reference ref.
+func SplitBinary(payload []byte, ref byte) ([]BinaryPart, error) {
+	if len(payload) <= MaxBinaryPartSize {
+		return []BinaryPart{{Data: payload}}, nil
+	}
+
+	partSize := MaxBinaryPartSize - concatUDHSize
+	total := (len(payload) + partSize - 1) / partSize
+	if total > 255 {
+		return nil, fmt.Errorf("payload of %d bytes needs %d parts, which exceeds the maximum of 255", len(payload), total)
+	}
+
+	parts := make([]BinaryPart, 0, total)
+	for seq := 1; seq <= total; seq++ {
+		start := (seq - 1) * partSize
+		end := start + partSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+		parts = append(parts, BinaryPart{
+			UDH:  ConcatUDH(ref, total, seq),
+			Data: payload[start:end],
+		})
+	}
+
+	return parts, nil
+}
+
+// CreateBinary creates a binary message with the UDH and payload of part,
+// e.g. for WAP push or SIM OTA. The UDH of part may be nil. Type and
+// TypeDetails of params are overwritten.
+func CreateBinary(c messagebird.Requester, originator string, recipients []string, part BinaryPart, params *Params) (*Message, error) {
+	if len(part.Data) == 0 {
+		return nil, errors.New("binary payload is required")
+	}
+	if len(part.UDH)+len(part.Data) > MaxBinaryPartSize {
+		return nil, fmt.Errorf("binary part of %d bytes exceeds the maximum of %d bytes", len(part.UDH)+len(part.Data), MaxBinaryPartSize)
+	}
+
+	binaryParams := &Params{}
+	if params != nil {
+		*binaryParams = *params
+	}
+	binaryParams.Type = TypeBinary
+	binaryParams.TypeDetails = TypeDetails{}
+	if len(part.UDH) > 0 {
+		binaryParams.TypeDetails["udh"] = hex.EncodeToString(part.UDH)
+	}
+
+	return Create(c, originator, recipients, hex.EncodeToString(part.Data), binaryParams)
+}
+
+// CreateBinaryConcatenated splits payload with SplitBinary and creates a binary
+// message per part. It stops at the first part that fails, returning the
+// messages created so far.
+func CreateBinaryConcatenated(c messagebird.Requester, originator string, recipients []string, payload []byte, ref byte, params *Params) ([]*Message, error) {
+	parts, err := SplitBinary(payload, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(parts))
+	for _, part := range parts {
+		message, err := CreateBinary(c, originator, recipients, part, params)
+		if err != nil {
+			return messages, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
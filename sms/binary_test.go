@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcatUDH(t *testing.T) {
+	assert.Equal(t, []byte{0x05, 0x00, 0x03, 0x34, 0x02, 0x01}, ConcatUDH(0x34, 2, 1))
+}
+
+func TestSplitBinary(t *testing.T) {
+	parts, err := SplitBinary(bytes.Repeat([]byte{0xff}, 140), 0x01)
+	assert.NoError(t, err)
+	assert.Len(t, parts, 1)
+	assert.Nil(t, parts[0].UDH)
+
+	payload := bytes.Repeat([]byte{0xab}, 300)
+	parts, err = SplitBinary(payload, 0x42)
+	assert.NoError(t, err)
+	assert.Len(t, parts, 3)
+	assert.Equal(t, ConcatUDH(0x42, 3, 1), parts[0].UDH)
+	assert.Equal(t, ConcatUDH(0x42, 3, 3), parts[2].UDH)
+	assert.Len(t, parts[0].Data, 134)
+	assert.Len(t, parts[2].Data, 32)
+	for _, part := range parts {
+		assert.LessOrEqual(t, len(part.UDH)+len(part.Data), MaxBinaryPartSize)
+	}
+
+	_, err = SplitBinary(make([]byte, 134*255+1), 0x01)
+	assert.Error(t, err)
+}
+
+func TestCreateBinary(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "binaryMessageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := CreateBinary(client, "TestName", []string{"31612345678"}, BinaryPart{
+		UDH:  ConcatUDH(0x34, 2, 1),
+		Data: []byte("Hello"),
+	}, &Params{Reference: "MyReference"})
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"originator": "TestName",
+		"body": "48656c6c6f",
+		"recipients": ["31612345678"],
+		"type": "binary",
+		"reference": "MyReference",
+		"typeDetails": {"udh": "050003340201"},
+		"mclass": 1,
+		"shortenUrls": false
+	}`, string(mbtest.Request.Body))
+
+	_, err = CreateBinary(client, "TestName", []string{"31612345678"}, BinaryPart{Data: make([]byte, 141)}, nil)
+	assert.Error(t, err)
+}
+
+func TestCreateBinaryConcatenated(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "binaryMessageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	messages, err := CreateBinaryConcatenated(client, "TestName", []string{"31612345678"}, make([]byte, 200), 0x01, nil)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Contains(t, string(mbtest.Request.Body), `"udh":"050003010202"`)
+}
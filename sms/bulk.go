@@ -0,0 +1,135 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// MaxRecipientsPerRequest is the maximum number of recipients the API accepts
+// in a single request.
+const MaxRecipientsPerRequest = 50
+
+// BulkResult is the aggregated result of CreateBulk.
+type BulkResult struct {
+	// Messages are the messages created, one per batch that succeeded.
+	Messages []*Message
+
+	// Recipients has the result per recipient, in the order of the
+	// recipients passed to CreateBulk. Contacts of the groups in
+	// Params.GroupIDs are not included.
+	Recipients []BulkRecipient
+
+	// Err is a *messagebird.ParallelError with the error of each batch if
+	// any of them failed, or the error of the arguments if no batch was
+	// sent.
+	Err error
+}
+
+// bulkBatch is a single request made by CreateBulk.
+type bulkBatch struct {
+	recipients []string
+	params     *Params
+}
+
+// BulkRecipient is the result of sending a message to one of the recipients
+// passed to CreateBulk.
+type BulkRecipient struct {
+	Recipient string
+	MessageID string // MessageID is empty if sending the batch failed.
+	Status    string // Status as reported when the message was created.
+	Err       error  // Err is the error of the recipient's batch, if any.
+}
+
+// Failed returns the recipients whose batch failed.
+func (r *BulkResult) Failed() []BulkRecipient {
+	var failed []BulkRecipient
+	for _, recipient := range r.Recipients {
+		if recipient.Err != nil {
+			failed = append(failed, recipient)
+		}
+	}
+	return failed
+}
+
+// CreateBulk creates messages for any number of recipients, splitting them in
+// batches of MaxRecipientsPerRequest. Up to concurrency batches are sent at
+// the same time (messagebird.DefaultConcurrency if not positive). A failed
+// batch does not stop the others: check the BulkResult's Err, and the Err of
+// its Recipients or Failed. When ctx is done, no more batches are sent.
+//
+// The groups in Params.GroupIDs are only sent to with the first batch, so
+// their contacts receive the message once.
+func CreateBulk(ctx context.Context, c messagebird.Requester, originator string, recipients []string, body string, params *Params, concurrency int) *BulkResult {
+	if len(recipients) == 0 && (params == nil || len(params.GroupIDs) == 0) {
+		return &BulkResult{Err: fmt.Errorf("%w: recipients or Params.GroupIDs are required", messagebird.ErrInvalidParams)}
+	}
+
+	var batches []bulkBatch
+	for start := 0; start < len(recipients); start += MaxRecipientsPerRequest {
+		end := start + MaxRecipientsPerRequest
+		if end > len(recipients) {
+			end = len(recipients)
+		}
+		batches = append(batches, bulkBatch{recipients: recipients[start:end], params: params})
+	}
+	if params != nil && len(params.GroupIDs) > 0 {
+		if len(batches) == 0 {
+			batches = append(batches, bulkBatch{params: params})
+		}
+
+		withoutGroups := *params
+		withoutGroups.GroupIDs = nil
+		for i := 1; i < len(batches); i++ {
+			batches[i].params = &withoutGroups
+		}
+	}
+
+	messages, err := messagebird.Parallel(ctx, batches, concurrency, func(ctx context.Context, batch bulkBatch) (*Message, error) {
+		requestData, err := requestDataForMessage(originator, batch.recipients, body, batch.params)
+		if err != nil {
+			return nil, err
+		}
+
+		message := &Message{}
+		if err := messagebird.RequestContext(ctx, c, message, http.MethodPost, path, requestData); err != nil {
+			return nil, createError(err)
+		}
+		return message, nil
+	})
+
+	var batchErrs []error
+	if parallelErr, ok := err.(*messagebird.ParallelError); ok {
+		batchErrs = parallelErr.Errors
+	}
+
+	result := &BulkResult{Recipients: make([]BulkRecipient, 0, len(recipients)), Err: err}
+	for i, batch := range batches {
+		if batchErrs != nil && batchErrs[i] != nil {
+			for _, recipient := range batch.recipients {
+				result.Recipients = append(result.Recipients, BulkRecipient{Recipient: recipient, Err: batchErrs[i]})
+			}
+			continue
+		}
+
+		message := messages[i]
+		result.Messages = append(result.Messages, message)
+
+		statuses := make(map[string]string, len(message.Recipients.Items))
+		for _, item := range message.Recipients.Items {
+			statuses[strconv.FormatInt(item.Recipient, 10)] = item.Status
+		}
+		for _, recipient := range batch.recipients {
+			result.Recipients = append(result.Recipients, BulkRecipient{
+				Recipient: recipient,
+				MessageID: message.ID,
+				Status:    statuses[recipient],
+			})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,132 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// batchRequester creates a message for every batch, except for batches
+// containing failRecipient, or every batch if err is set.
+type batchRequester struct {
+	mu            sync.Mutex
+	err           error
+	failRecipient string
+	batchSizes    []int
+	groupIDs      [][]string
+}
+
+func (r *batchRequester) Request(v interface{}, method, path string, data interface{}) error {
+	request := data.(*messageRequest)
+
+	r.mu.Lock()
+	r.batchSizes = append(r.batchSizes, len(request.Recipients))
+	r.groupIDs = append(r.groupIDs, request.GroupIDs)
+	r.mu.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+
+	message := &Message{ID: "id-groups"}
+	if len(request.Recipients) > 0 {
+		message.ID = "id-" + request.Recipients[0]
+	}
+	for _, recipient := range request.Recipients {
+		if recipient == r.failRecipient {
+			return errors.New("batch failed")
+		}
+		msisdn, _ := strconv.ParseInt(recipient, 10, 64)
+		message.Recipients.Items = append(message.Recipients.Items, messagebird.Recipient{Recipient: msisdn, Status: "sent"})
+	}
+
+	b, _ := json.Marshal(message)
+	return json.Unmarshal(b, v)
+}
+
+func TestCreateBulk(t *testing.T) {
+	recipients := make([]string, 120)
+	for i := range recipients {
+		recipients[i] = strconv.Itoa(31612345000 + i)
+	}
+	requester := &batchRequester{failRecipient: recipients[75]}
+
+	result := CreateBulk(context.Background(), requester, "TestName", recipients, "Hello World", nil, 2)
+	assert.ElementsMatch(t, []int{50, 50, 20}, requester.batchSizes)
+	assert.Len(t, result.Messages, 2)
+	assert.Len(t, result.Recipients, 120)
+
+	assert.Equal(t, recipients[0], result.Recipients[0].Recipient)
+	assert.Equal(t, "id-"+recipients[0], result.Recipients[0].MessageID)
+	assert.Equal(t, "sent", result.Recipients[0].Status)
+	assert.NoError(t, result.Recipients[0].Err)
+
+	assert.Equal(t, "id-"+recipients[100], result.Recipients[119].MessageID)
+
+	failed := result.Failed()
+	assert.Len(t, failed, 50)
+	assert.Equal(t, recipients[50], failed[0].Recipient)
+	assert.EqualError(t, failed[0].Err, "batch failed")
+	assert.Empty(t, failed[0].MessageID)
+
+	var parallelErr *messagebird.ParallelError
+	assert.ErrorAs(t, result.Err, &parallelErr)
+	assert.Len(t, parallelErr.Errors, 3)
+	assert.EqualError(t, parallelErr.Errors[1], "batch failed")
+}
+
+func TestCreateBulkInvalid(t *testing.T) {
+	result := CreateBulk(context.Background(), &batchRequester{}, "", []string{"31612345678"}, "Hello World", nil, 0)
+	assert.EqualError(t, result.Recipients[0].Err, "invalid or missing parameters: originator is required")
+	assert.Error(t, result.Err)
+}
+
+func TestCreateBulkNoRecipients(t *testing.T) {
+	requester := &batchRequester{}
+
+	result := CreateBulk(context.Background(), requester, "TestName", nil, "Hello World", &Params{}, 0)
+	assert.ErrorIs(t, result.Err, messagebird.ErrInvalidParams)
+	assert.Empty(t, requester.batchSizes)
+	assert.Empty(t, result.Messages)
+}
+
+func TestCreateBulkGroups(t *testing.T) {
+	recipients := make([]string, 120)
+	for i := range recipients {
+		recipients[i] = strconv.Itoa(31612345000 + i)
+	}
+	requester := &batchRequester{}
+	params := &Params{GroupIDs: []string{"group-1", "group-2"}}
+
+	result := CreateBulk(context.Background(), requester, "TestName", recipients, "Hello World", params, 1)
+	assert.Len(t, result.Messages, 3)
+	assert.Equal(t, [][]string{{"group-1", "group-2"}, nil, nil}, requester.groupIDs)
+	assert.Equal(t, []string{"group-1", "group-2"}, params.GroupIDs)
+}
+
+func TestCreateBulkGroupsOnly(t *testing.T) {
+	requester := &batchRequester{}
+
+	result := CreateBulk(context.Background(), requester, "TestName", nil, "Hello World", &Params{GroupIDs: []string{"group-1"}}, 0)
+	assert.Equal(t, []int{0}, requester.batchSizes)
+	assert.Equal(t, [][]string{{"group-1"}}, requester.groupIDs)
+	assert.Len(t, result.Messages, 1)
+	assert.Equal(t, "id-groups", result.Messages[0].ID)
+	assert.Empty(t, result.Recipients)
+	assert.NoError(t, result.Err)
+}
+
+func TestCreateBulkGroupsOnlyFailed(t *testing.T) {
+	requester := &batchRequester{err: errors.New("batch failed")}
+
+	result := CreateBulk(context.Background(), requester, "TestName", nil, "Hello World", &Params{GroupIDs: []string{"group-1"}}, 0)
+	assert.Empty(t, result.Messages)
+	assert.Empty(t, result.Recipients)
+	assert.EqualError(t, result.Err, "1 of 1 calls failed, first error: batch failed")
+}
@@ -0,0 +1,64 @@
+package sms
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/webhook"
+	"github.com/messagebird/go-rest-api/v7/signature"
+)
+
+// Click is a click on a short link of a message sent with ShortenURLs, as
+// reported to the click URL configured for the account.
+type Click struct {
+	ID              string // The ID of the message the link was sent in.
+	Reference       string // The client reference of the message.
+	Recipient       string
+	URL             string // The original URL the short link redirected to.
+	ShortURL        string
+	ClickedDatetime time.Time
+}
+
+// ParseClick parses the click event in r. Clicks are reported as query
+// parameters or form-encoded POST bodies.
+func ParseClick(r *http.Request) (*Click, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid click: %w", err)
+	}
+	form := r.Form
+
+	click := &Click{
+		ID:        form.Get("id"),
+		Reference: form.Get("reference"),
+		Recipient: form.Get("recipient"),
+		URL:       form.Get("url"),
+		ShortURL:  form.Get("shortUrl"),
+	}
+
+	if click.ID == "" {
+		return nil, errors.New("invalid click: id is missing")
+	}
+	if click.URL == "" {
+		return nil, errors.New("invalid click: url is missing")
+	}
+
+	if v := form.Get("clickedDatetime"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid click: clickedDatetime: %w", err)
+		}
+		click.ClickedDatetime = t
+	}
+
+	return click, nil
+}
+
+// ClickHandler returns a handler that parses click events and passes them to
+// fn. If validator is not nil, requests with an invalid signature are
+// rejected with 401 Unauthorized. Invalid clicks are rejected with 400 Bad
+// Request.
+func ClickHandler(validator *signature.Validator, fn func(*Click)) http.Handler {
+	return webhook.Handler(validator, ParseClick, fn)
+}
@@ -0,0 +1,57 @@
+package sms
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClick(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/clicks?id=6fe65f90454aa61536e6a88b88972670&reference=order-1234&recipient=31612345678&url=https%3A%2F%2Fexample.com%2Fsale&shortUrl=https%3A%2F%2Fmbird.link%2Fabc&clickedDatetime=2021-03-01T10%3A00%3A00%2B00%3A00", nil)
+
+	click, err := ParseClick(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "6fe65f90454aa61536e6a88b88972670", click.ID)
+	assert.Equal(t, "order-1234", click.Reference)
+	assert.Equal(t, "31612345678", click.Recipient)
+	assert.Equal(t, "https://example.com/sale", click.URL)
+	assert.Equal(t, "https://mbird.link/abc", click.ShortURL)
+	assert.Equal(t, "2021-03-01T10:00:00Z", click.ClickedDatetime.UTC().Format(time.RFC3339))
+}
+
+func TestParseClickInvalid(t *testing.T) {
+	for _, query := range []string{
+		"url=https%3A%2F%2Fexample.com",
+		"id=6fe65f90454aa61536e6a88b88972670",
+		"id=6fe65f90454aa61536e6a88b88972670&url=https%3A%2F%2Fexample.com&clickedDatetime=now",
+	} {
+		_, err := ParseClick(httptest.NewRequest(http.MethodGet, "/clicks?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+func TestClickHandler(t *testing.T) {
+	var received []*Click
+	h := ClickHandler(signature.NewValidator("secret"), func(c *Click) { received = append(received, c) })
+
+	query := "id=6fe65f90454aa61536e6a88b88972670&url=https%3A%2F%2Fexample.com"
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", query))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("wrong", query))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", "id=6fe65f90454aa61536e6a88b88972670"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, "https://example.com", received[0].URL)
+}
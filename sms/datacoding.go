@@ -0,0 +1,151 @@
+package sms
+
+import "strings"
+
+// Data codings of messages.
+const (
+	DataCodingPlain   = "plain"   // GSM 03.38 7-bit alphabet.
+	DataCodingUnicode = "unicode" // UCS-2, for characters outside the GSM 7-bit alphabet.
+
+	// DataCodingAuto makes Create choose DataCodingPlain or DataCodingUnicode
+	// based on the body, see DetectDataCoding.
+	DataCodingAuto = "auto"
+)
+
+// gsm7Basic is the GSM 03.38 basic character set, without the escape
+// character.
+const gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7Extension is the GSM 03.38 extension table. Its characters take two
+// septets: an escape and the character.
+const gsm7Extension = "\f^{}\\[~]|€"
+
+// isGSM7Rune reports whether r can be encoded in the GSM 7-bit alphabet.
+func isGSM7Rune(r rune) bool {
+	return strings.ContainsRune(gsm7Basic, r) || strings.ContainsRune(gsm7Extension, r)
+}
+
+// IsGSM7 reports whether body can be sent with DataCodingPlain, i.e. all its
+// characters are in the GSM 7-bit alphabet.
+func IsGSM7(body string) bool {
+	for _, r := range body {
+		if !isGSM7Rune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// DetectDataCoding returns DataCodingPlain if body can be encoded in the GSM
+// 7-bit alphabet, and DataCodingUnicode otherwise.
+func DetectDataCoding(body string) string {
+	if IsGSM7(body) {
+		return DataCodingPlain
+	}
+	return DataCodingUnicode
+}
+
+// transliterationPairs are pairs of common characters outside the GSM 7-bit
+// alphabet and their lookalikes inside it.
+var transliterationPairs = []string{
+	"‘", "'", "’", "'", "‚", "'", "‛", "'", "´", "'", "`", "'",
+	"“", "\"", "”", "\"", "„", "\"", "«", "\"", "»", "\"",
+	"–", "-", "—", "-", "‐", "-", "−", "-", "•", "-",
+	"…", "...", " ", " ", "\t", " ",
+	"á", "a", "â", "a", "ã", "a",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A",
+	"ç", "c", "ê", "e", "ë", "e", "È", "E", "Ê", "E", "Ë", "E",
+	"í", "i", "î", "i", "ï", "i", "Í", "I", "Ì", "I", "Î", "I", "Ï", "I",
+	"ó", "o", "ô", "o", "õ", "o", "Ó", "O", "Ò", "O", "Ô", "O", "Õ", "O",
+	"ú", "u", "û", "u", "Ú", "U", "Ù", "U", "Û", "U",
+	"ý", "y", "ÿ", "y", "Ý", "Y",
+	"œ", "oe", "Œ", "OE",
+}
+
+var transliterations = strings.NewReplacer(transliterationPairs...)
+
+// Transliterate replaces common characters outside the GSM 7-bit alphabet,
+// e.g. curly quotes and accented letters, with lookalikes inside it. Other
+// characters, e.g. emoji, are left as is, so the result may still need
+// DataCodingUnicode.
+func Transliterate(body string) string {
+	return transliterations.Replace(body)
+}
+
+// Number of characters that fit a message part, per data coding.
+const (
+	gsm7SinglePartLength    = 160
+	gsm7MultiPartLength     = 153
+	unicodeSinglePartLength = 70
+	unicodeMultiPartLength  = 67
+)
+
+// PartCount describes how a body is split into message parts.
+type PartCount struct {
+	// DataCoding is the data coding the count is for, DataCodingPlain or
+	// DataCodingUnicode.
+	DataCoding string
+
+	// Length is the length of the body in characters of the data coding:
+	// septets for DataCodingPlain, in which characters of the extension
+	// table count double, or UTF-16 code units for DataCodingUnicode, in
+	// which emoji count double.
+	Length int
+
+	// Parts is the number of parts the body is sent in.
+	Parts int
+
+	// PerPart is the number of characters per part.
+	PerPart int
+
+	// Remaining is the number of characters that can be added without
+	// needing another part.
+	Remaining int
+}
+
+// CountParts returns how body is split into parts when sent with dataCoding.
+// With DataCodingAuto, the data coding is chosen like Create does; an empty
+// dataCoding means DataCodingPlain, the API's default. Use it to show the
+// number of parts, and thus the cost, before calling Create.
+func CountParts(body, dataCoding string) PartCount {
+	switch dataCoding {
+	case DataCodingAuto:
+		dataCoding = DetectDataCoding(body)
+	case "":
+		dataCoding = DataCodingPlain
+	}
+
+	count := PartCount{DataCoding: dataCoding}
+	singlePartLength, multiPartLength := gsm7SinglePartLength, gsm7MultiPartLength
+	if dataCoding == DataCodingUnicode {
+		singlePartLength, multiPartLength = unicodeSinglePartLength, unicodeMultiPartLength
+		for _, r := range body {
+			if r > 0xffff {
+				count.Length += 2
+			} else {
+				count.Length++
+			}
+		}
+	} else {
+		for _, r := range body {
+			if strings.ContainsRune(gsm7Extension, r) {
+				count.Length += 2
+			} else {
+				count.Length++
+			}
+		}
+	}
+
+	if count.Length <= singlePartLength {
+		count.Parts = 1
+		count.PerPart = singlePartLength
+		count.Remaining = singlePartLength - count.Length
+		return count
+	}
+
+	count.PerPart = multiPartLength
+	count.Parts = (count.Length + multiPartLength - 1) / multiPartLength
+	count.Remaining = count.Parts*multiPartLength - count.Length
+	return count
+}
@@ -0,0 +1,76 @@
+package sms
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectDataCoding(t *testing.T) {
+	assert.Equal(t, DataCodingPlain, DetectDataCoding("Hello World"))
+	assert.Equal(t, DataCodingPlain, DetectDataCoding("Prijs: €10 {incl. BTW} @ Café"))
+	assert.Equal(t, DataCodingUnicode, DetectDataCoding("It’s here"))
+	assert.Equal(t, DataCodingUnicode, DetectDataCoding("Hello 👋"))
+	assert.Equal(t, DataCodingUnicode, DetectDataCoding("Привет"))
+}
+
+func TestTransliterate(t *testing.T) {
+	assert.Equal(t, `It's "here" - finally...`, Transliterate("It’s “here” – finally…"))
+	assert.Equal(t, "Crème brulée à la facon", Transliterate("Crème brûlée à la façon"))
+	assert.True(t, IsGSM7(Transliterate("Señor García, ¿cómo está?")))
+	assert.False(t, IsGSM7(Transliterate("Hello 👋")))
+}
+
+func TestTransliterationPairs(t *testing.T) {
+	for i := 0; i < len(transliterationPairs); i += 2 {
+		from, to := transliterationPairs[i], transliterationPairs[i+1]
+		assert.False(t, IsGSM7(from), "%q is already in the GSM 7-bit alphabet", from)
+		assert.True(t, IsGSM7(to), "%q is not in the GSM 7-bit alphabet", to)
+	}
+
+	assert.Equal(t, "Ångström", Transliterate("Ångström"))
+}
+
+func TestRequestDataForMessageAutoDataCoding(t *testing.T) {
+	request, err := requestDataForMessage("TestName", []string{"31612345678"}, "It’s here", &Params{DataCoding: DataCodingAuto})
+	assert.NoError(t, err)
+	assert.Equal(t, DataCodingUnicode, request.DataCoding)
+	assert.Equal(t, "It’s here", request.Body)
+
+	request, err = requestDataForMessage("TestName", []string{"31612345678"}, "It’s here", &Params{DataCoding: DataCodingAuto, Transliterate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, DataCodingPlain, request.DataCoding)
+	assert.Equal(t, "It's here", request.Body)
+
+	// Transliterate is only used with DataCodingAuto.
+	request, err = requestDataForMessage("TestName", []string{"31612345678"}, "It’s here", &Params{DataCoding: DataCodingUnicode, Transliterate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "It’s here", request.Body)
+}
+
+func TestCountParts(t *testing.T) {
+	tt := []struct {
+		name       string
+		body       string
+		dataCoding string
+		want       PartCount
+	}{
+		{"empty", "", "", PartCount{DataCodingPlain, 0, 1, 160, 160}},
+		{"single plain", "Hello World", DataCodingPlain, PartCount{DataCodingPlain, 11, 1, 160, 149}},
+		{"full plain", strings.Repeat("a", 160), DataCodingPlain, PartCount{DataCodingPlain, 160, 1, 160, 0}},
+		{"multi plain", strings.Repeat("a", 161), DataCodingPlain, PartCount{DataCodingPlain, 161, 2, 153, 145}},
+		{"extension", strings.Repeat("€", 80), DataCodingPlain, PartCount{DataCodingPlain, 160, 1, 160, 0}},
+		{"unicode", "Привет", DataCodingUnicode, PartCount{DataCodingUnicode, 6, 1, 70, 64}},
+		{"multi unicode", strings.Repeat("я", 71), DataCodingUnicode, PartCount{DataCodingUnicode, 71, 2, 67, 63}},
+		{"emoji", "👋", DataCodingUnicode, PartCount{DataCodingUnicode, 2, 1, 70, 68}},
+		{"auto plain", "Hello", DataCodingAuto, PartCount{DataCodingPlain, 5, 1, 160, 155}},
+		{"auto unicode", "Hello 👋", DataCodingAuto, PartCount{DataCodingUnicode, 8, 1, 70, 62}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, CountParts(tc.body, tc.dataCoding))
+		})
+	}
+}
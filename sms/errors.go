@@ -0,0 +1,42 @@
+package sms
+
+import (
+	"errors"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/sentinel"
+)
+
+// Errors returned by Create (and the other functions sending messages) when
+// the API rejected the message. Match them with errors.Is; a balance that is
+// too low matches messagebird.ErrNotEnoughBalance. These errors are
+// permanent: use messagebird.IsTemporary to find out whether sending may
+// succeed when it's retried. The original messagebird.ErrorResponse is still
+// available through errors.As.
+var (
+	// ErrInvalidRecipient is returned when one of the recipients is not a
+	// valid phone number.
+	ErrInvalidRecipient = errors.New("sms: invalid recipient")
+
+	// ErrInvalidOriginator is returned when the originator is not allowed,
+	// e.g. because it isn't a number or alphanumeric ID registered for the
+	// account.
+	ErrInvalidOriginator = errors.New("sms: invalid originator")
+)
+
+// createError maps err, as returned when creating a message, to one of the
+// send sentinel errors. Other errors are returned as is.
+func createError(err error) error {
+	var mbErr messagebird.Error
+	if !errors.As(err, &mbErr) || !errors.Is(mbErr, messagebird.ErrInvalidParams) {
+		return err
+	}
+
+	switch mbErr.Parameter {
+	case "recipient", "recipients":
+		return sentinel.Wrap(ErrInvalidRecipient, err)
+	case "originator":
+		return sentinel.Wrap(ErrInvalidOriginator, err)
+	}
+	return err
+}
@@ -0,0 +1,44 @@
+package sms
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateInvalidRecipient(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"errors":[{"code":9,"description":"no (correct) recipients found","parameter":"recipients"}]}`), http.StatusUnprocessableEntity)
+	client := mbtest.Client(t)
+
+	_, err := Create(client, "TestName", []string{"31612345678"}, "Hello World", nil)
+	assert.True(t, errors.Is(err, ErrInvalidRecipient))
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+	assert.False(t, messagebird.IsTemporary(err))
+
+	var errResp messagebird.ErrorResponse
+	assert.True(t, errors.As(err, &errResp))
+	assert.Equal(t, "recipients", errResp.Errors[0].Parameter)
+}
+
+func TestCreateNotEnoughBalance(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"errors":[{"code":25,"description":"Not enough balance","parameter":null}]}`), http.StatusPaymentRequired)
+	client := mbtest.Client(t)
+
+	_, err := Create(client, "TestName", []string{"31612345678"}, "Hello World", nil)
+	assert.True(t, errors.Is(err, messagebird.ErrNotEnoughBalance))
+	assert.False(t, errors.Is(err, ErrInvalidRecipient))
+	assert.False(t, messagebird.IsTemporary(err))
+}
+
+func TestCreateErrorMapping(t *testing.T) {
+	originatorErr := messagebird.ErrorResponse{Errors: []messagebird.Error{{Code: messagebird.ErrorCodeInvalidParams, Parameter: "originator"}}}
+	assert.True(t, errors.Is(createError(originatorErr), ErrInvalidOriginator))
+
+	internalErr := messagebird.ErrorResponse{Errors: []messagebird.Error{{Code: messagebird.ErrorCodeInternalError}}, StatusCode: http.StatusInternalServerError}
+	assert.Equal(t, internalErr, createError(internalErr))
+	assert.True(t, messagebird.IsTemporary(createError(internalErr)))
+}
@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/webhook"
+	"github.com/messagebird/go-rest-api/v7/signature"
+)
+
+// InboundMessage is a message received on one of your numbers, as forwarded
+// to the URL configured for the number.
+type InboundMessage struct {
+	ID              string
+	Recipient       string // The number the message was sent to.
+	Originator      string // The sender of the message.
+	Body            string
+	MCC             string // Mobile country code of the sender's network.
+	MNC             string // Mobile network code of the sender's network.
+	CreatedDatetime time.Time
+}
+
+// ParseInbound parses the inbound message in r. Messages are forwarded as
+// query parameters or form-encoded POST bodies.
+func ParseInbound(r *http.Request) (*InboundMessage, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid inbound message: %w", err)
+	}
+	form := r.Form
+
+	message := &InboundMessage{
+		ID:         form.Get("id"),
+		Recipient:  form.Get("recipient"),
+		Originator: form.Get("originator"),
+		Body:       form.Get("body"),
+		MCC:        form.Get("mcc"),
+		MNC:        form.Get("mnc"),
+	}
+
+	// Some numbers forward the network as a single mccmnc parameter.
+	if mccmnc := form.Get("mccmnc"); message.MCC == "" && len(mccmnc) >= 5 {
+		message.MCC, message.MNC = mccmnc[:3], mccmnc[3:]
+	}
+
+	if message.Originator == "" {
+		return nil, errors.New("invalid inbound message: originator is missing")
+	}
+	if message.Recipient == "" {
+		return nil, errors.New("invalid inbound message: recipient is missing")
+	}
+
+	if v := form.Get("createdDatetime"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inbound message: createdDatetime: %w", err)
+		}
+		message.CreatedDatetime = t
+	}
+
+	return message, nil
+}
+
+// InboundHandler returns a handler that parses inbound messages and passes
+// them to fn. If validator is not nil, requests with an invalid signature are
+// rejected with 401 Unauthorized. Invalid messages are rejected with 400 Bad
+// Request.
+func InboundHandler(validator *signature.Validator, fn func(*InboundMessage)) http.Handler {
+	return webhook.Handler(validator, ParseInbound, fn)
+}
@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInbound(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/inbound?id=e8077d803532c0b5937c639b60216938&recipient=3197010260000&originator=31612345678&body=STOP&mcc=204&mnc=08&createdDatetime=2021-03-01T10%3A00%3A00%2B00%3A00", nil)
+
+	message, err := ParseInbound(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "e8077d803532c0b5937c639b60216938", message.ID)
+	assert.Equal(t, "3197010260000", message.Recipient)
+	assert.Equal(t, "31612345678", message.Originator)
+	assert.Equal(t, "STOP", message.Body)
+	assert.Equal(t, "204", message.MCC)
+	assert.Equal(t, "08", message.MNC)
+	assert.Equal(t, "2021-03-01T10:00:00Z", message.CreatedDatetime.UTC().Format(time.RFC3339))
+}
+
+func TestParseInboundForm(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/inbound", strings.NewReader("recipient=3197010260000&originator=31612345678&body=Hello+there&mccmnc=20408"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	message, err := ParseInbound(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello there", message.Body)
+	assert.Equal(t, "204", message.MCC)
+	assert.Equal(t, "08", message.MNC)
+	assert.True(t, message.CreatedDatetime.IsZero())
+}
+
+func TestParseInboundInvalid(t *testing.T) {
+	for _, query := range []string{
+		"recipient=3197010260000&body=Hi",
+		"originator=31612345678&body=Hi",
+		"recipient=3197010260000&originator=31612345678&createdDatetime=now",
+	} {
+		_, err := ParseInbound(httptest.NewRequest(http.MethodGet, "/inbound?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+func TestInboundHandler(t *testing.T) {
+	var received []*InboundMessage
+	h := InboundHandler(signature.NewValidator("secret"), func(m *InboundMessage) { received = append(received, m) })
+
+	query := "body=Hi&originator=31612345678&recipient=3197010260000"
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", query))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("wrong", query))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", "body=Hi"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, "Hi", received[0].Body)
+}
@@ -0,0 +1,82 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// MessageIterator iterates over all messages matching a ListParams,
+// transparently fetching subsequent pages.
+//
+//	it := sms.Iterate(ctx, client, &sms.ListParams{Status: "delivered"})
+//	for it.Next() {
+//		message := it.Message()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle error.
+//	}
+type MessageIterator struct {
+	*messagebird.Iterator
+}
+
+// Message returns the current message. It must only be called after a call to
+// Next returned true.
+func (it *MessageIterator) Message() *Message {
+	return it.Value().(*Message)
+}
+
+// Iterate returns an iterator over all messages matching params. The Limit of
+// params is used as the page size and iteration starts at its Offset. Requests
+// are bound to ctx, and iteration stops when ctx is done.
+func Iterate(ctx context.Context, c messagebird.Requester, params *ListParams) *MessageIterator {
+	var p ListParams
+	if params != nil {
+		p = *params
+	}
+	start := p.Offset
+
+	fetch := func(ctx context.Context, offset, limit int) (interface{}, int, error) {
+		p.Offset = start + offset
+		p.Limit = limit
+		urlParams, err := paramsForMessageList(&p)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		messageList := &MessageList{}
+		if err := messagebird.RequestContext(ctx, c, messageList, http.MethodGet, path+"?"+urlParams.Encode(), nil); err != nil {
+			return nil, 0, err
+		}
+
+		items := make([]*Message, len(messageList.Items))
+		for i := range messageList.Items {
+			items[i] = &messageList.Items[i]
+		}
+		return items, messageList.TotalCount - start, nil
+	}
+
+	return &MessageIterator{messagebird.NewIterator(ctx, p.Limit, fetch)}
+}
+
+// FindByReference returns all messages that were created with the given
+// client reference, e.g. an order ID.
+func FindByReference(ctx context.Context, c messagebird.Requester, reference string) ([]*Message, error) {
+	if reference == "" {
+		return nil, errors.New("reference is required")
+	}
+
+	var messages []*Message
+	it := Iterate(ctx, c, &ListParams{Reference: reference})
+	for it.Next() {
+		messages = append(messages, it.Message())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
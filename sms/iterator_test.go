@@ -0,0 +1,78 @@
+package sms
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pageRequester serves the message list from total messages, recording the
+// query of every request.
+type pageRequester struct {
+	total   int
+	queries []url.Values
+}
+
+func (r *pageRequester) Request(v interface{}, method, path string, data interface{}) error {
+	query, _ := url.ParseQuery(path[strings.Index(path, "?")+1:])
+	r.queries = append(r.queries, query)
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	list := v.(*MessageList)
+	list.TotalCount = r.total
+	for i := offset; i < offset+limit && i < r.total; i++ {
+		list.Items = append(list.Items, Message{ID: strconv.Itoa(i)})
+	}
+	return nil
+}
+
+func TestIterate(t *testing.T) {
+	requester := &pageRequester{total: 5}
+
+	it := Iterate(context.Background(), requester, &ListParams{Status: "delivered", Limit: 2, Offset: 1})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Message().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	assert.Len(t, requester.queries, 2)
+	assert.Equal(t, "delivered", requester.queries[0].Get("status"))
+	assert.Equal(t, "1", requester.queries[0].Get("offset"))
+	assert.Equal(t, "3", requester.queries[1].Get("offset"))
+	assert.Equal(t, "2", requester.queries[1].Get("limit"))
+}
+
+func TestIterateContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	requester := &pageRequester{total: 100}
+
+	it := Iterate(ctx, requester, nil)
+	assert.True(t, it.Next())
+	cancel()
+
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+	assert.Len(t, requester.queries, 1)
+	assert.Equal(t, "20", requester.queries[0].Get("limit"))
+}
+
+func TestFindByReference(t *testing.T) {
+	requester := &pageRequester{total: 3}
+
+	messages, err := FindByReference(context.Background(), requester, "order-1234")
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+	assert.Equal(t, "order-1234", requester.queries[0].Get("reference"))
+
+	_, err = FindByReference(context.Background(), requester, "")
+	assert.EqualError(t, err, "reference is required")
+}
@@ -1,7 +1,7 @@
 package sms
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -10,6 +10,14 @@ import (
 	messagebird "github.com/messagebird/go-rest-api/v7"
 )
 
+// Types of messages.
+const (
+	TypeSMS     = "sms"
+	TypeBinary  = "binary"
+	TypeFlash   = "flash"
+	TypePremium = "premium"
+)
+
 // TypeDetails is a hash with extra information.
 // Is only used when a binary or premium message is sent.
 type TypeDetails map[string]interface{}
@@ -46,15 +54,52 @@ type MessageList struct {
 
 // Params provide additional message send options and used in URL as params.
 type Params struct {
-	Type              string
-	Reference         string
-	Validity          int
-	Gateway           int
-	TypeDetails       TypeDetails
-	DataCoding        string
-	ReportURL         string
+	Type      string
+	Reference string
+
+	// Validity is the number of seconds the message may be delivered in.
+	// Networks drop the message when it could not be delivered in time,
+	// instead of delivering it late, e.g. for one-time passwords. Use
+	// ValiditySeconds to convert a time.Duration.
+	Validity int
+
+	// Gateway is the ID of the route the message is sent through. It is
+	// chosen by MessageBird if zero.
+	Gateway int
+
+	TypeDetails TypeDetails
+	DataCoding  string
+
+	// ReportURL is the URL status reports of this message are sent to,
+	// overriding the URL configured for the account. It must be an absolute
+	// http or https URL. Reports can be handled with a ReportHandler.
+	ReportURL string
+
 	ScheduledDatetime time.Time
-	ShortenURLs       bool
+
+	// ShortenURLs replaces the URLs in the body with short links, which
+	// track the clicks of the recipients. Clicks are reported to the click
+	// URL configured for the account, and can be handled with ClickHandler.
+	ShortenURLs bool
+
+	// Flash sends the message as a flash message (class 0), which is
+	// displayed on the handset immediately instead of being stored in its
+	// inbox. It sets Type to TypeFlash.
+	Flash bool
+
+	// Premium sends the message as a premium-rate message. It sets Type to
+	// TypePremium and TypeDetails to the premium details.
+	Premium *Premium
+
+	// Transliterate replaces characters outside the GSM 7-bit alphabet with
+	// lookalikes when DataCoding is DataCodingAuto, see Transliterate, so
+	// more messages can be sent with DataCodingPlain.
+	Transliterate bool
+
+	// GroupIDs are the IDs of contact groups whose contacts receive the
+	// message, in addition to the recipients. The recipients may be empty if
+	// at least one group is given.
+	GroupIDs []string
 }
 
 // ListParams provides additional message list options.
@@ -63,6 +108,9 @@ type ListParams struct {
 	Direction  string
 	Type       string
 	Status     string
+	Reference  string    // Only list messages with this client reference.
+	From       time.Time // Only list messages created at or after From.
+	Until      time.Time // Only list messages created before Until.
 	Limit      int
 	Offset     int
 }
@@ -70,24 +118,31 @@ type ListParams struct {
 type messageRequest struct {
 	Originator        string      `json:"originator"`
 	Body              string      `json:"body"`
-	Recipients        []string    `json:"recipients"`
+	Recipients        []string    `json:"recipients,omitempty"`
+	GroupIDs          []string    `json:"groupIds,omitempty"`
 	Type              string      `json:"type,omitempty"`
 	Reference         string      `json:"reference,omitempty"`
 	Validity          int         `json:"validity,omitempty"`
 	Gateway           int         `json:"gateway,omitempty"`
 	TypeDetails       TypeDetails `json:"typeDetails,omitempty"`
 	DataCoding        string      `json:"datacoding,omitempty"`
-	MClass            int         `json:"mclass,omitempty"`
+	MClass            *int        `json:"mclass,omitempty"`
 	ReportURL         string      `json:"reportUrl,omitempty"`
 	ScheduledDatetime string      `json:"scheduledDatetime,omitempty"`
 	ShortenURLs       bool        `json:"shortenUrls"`
 }
 
+// ValiditySeconds converts d to a Params.Validity, rounding up to whole
+// seconds so the message is never dropped earlier than d.
+func ValiditySeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
 // path represents the path to the Message resource.
 const path = "messages"
 
 // Read retrieves the information of an existing Message.
-func Read(c *messagebird.Client, id string) (*Message, error) {
+func Read(c messagebird.Requester, id string) (*Message, error) {
 	message := &Message{}
 	if err := c.Request(message, http.MethodGet, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -96,8 +151,10 @@ func Read(c *messagebird.Client, id string) (*Message, error) {
 	return message, nil
 }
 
-// Cancel sending Scheduled Sms.
-func Delete(c *messagebird.Client, id string) (*Message, error) {
+// Delete deletes an existing Message by its ID, e.g. to cancel sending a
+// scheduled message. The API responds without content, so the returned
+// Message is empty unless the API includes the deleted message.
+func Delete(c messagebird.Requester, id string) (*Message, error) {
 	message := &Message{}
 	if err := c.Request(message, http.MethodDelete, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -107,7 +164,7 @@ func Delete(c *messagebird.Client, id string) (*Message, error) {
 }
 
 // List retrieves all messages of the user represented as a MessageList object.
-func List(c *messagebird.Client, msgListParams *ListParams) (*MessageList, error) {
+func List(c messagebird.Requester, msgListParams *ListParams) (*MessageList, error) {
 	messageList := &MessageList{}
 	params, err := paramsForMessageList(msgListParams)
 	if err != nil {
@@ -122,7 +179,7 @@ func List(c *messagebird.Client, msgListParams *ListParams) (*MessageList, error
 }
 
 // Create creates a new message for one or more recipients.
-func Create(c *messagebird.Client, originator string, recipients []string, body string, msgParams *Params) (*Message, error) {
+func Create(c messagebird.Requester, originator string, recipients []string, body string, msgParams *Params) (*Message, error) {
 	requestData, err := requestDataForMessage(originator, recipients, body, msgParams)
 	if err != nil {
 		return nil, err
@@ -130,21 +187,21 @@ func Create(c *messagebird.Client, originator string, recipients []string, body
 
 	message := &Message{}
 	if err := c.Request(message, http.MethodPost, path, requestData); err != nil {
-		return nil, err
+		return nil, createError(err)
 	}
 
 	return message, nil
 }
 
 func requestDataForMessage(originator string, recipients []string, body string, params *Params) (*messageRequest, error) {
-	if originator == "" {
-		return nil, errors.New("originator is required")
+	if err := messagebird.ValidateOriginator(originator); err != nil {
+		return nil, err
 	}
-	if len(recipients) == 0 {
-		return nil, errors.New("at least 1 recipient is required")
+	if len(recipients) == 0 && (params == nil || len(params.GroupIDs) == 0) {
+		return nil, fmt.Errorf("%w: at least 1 recipient or group is required", messagebird.ErrInvalidParams)
 	}
 	if body == "" {
-		return nil, errors.New("body is required")
+		return nil, fmt.Errorf("%w: body is required", messagebird.ErrInvalidParams)
 	}
 
 	request := &messageRequest{
@@ -156,13 +213,24 @@ func requestDataForMessage(originator string, recipients []string, body string,
 	if params == nil {
 		return request, nil
 	}
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	request.GroupIDs = params.GroupIDs
+	request.Type = params.messageType()
+	request.TypeDetails = params.TypeDetails
+	if params.Premium != nil {
+		request.TypeDetails = params.Premium.typeDetails()
+	}
 
-	request.Type = params.Type
-	if request.Type == "flash" {
-		request.MClass = 0
-	} else {
-		request.MClass = 1
+	// Flash messages are class 0 messages, which are displayed immediately
+	// rather than stored on the handset.
+	mclass := 1
+	if request.Type == TypeFlash {
+		mclass = 0
 	}
+	request.MClass = &mclass
 
 	if !params.ScheduledDatetime.IsZero() {
 		request.ScheduledDatetime = params.ScheduledDatetime.Format(time.RFC3339)
@@ -171,14 +239,86 @@ func requestDataForMessage(originator string, recipients []string, body string,
 	request.Reference = params.Reference
 	request.Validity = params.Validity
 	request.Gateway = params.Gateway
-	request.TypeDetails = params.TypeDetails
 	request.DataCoding = params.DataCoding
+	if request.DataCoding == DataCodingAuto {
+		if params.Transliterate {
+			request.Body = Transliterate(request.Body)
+		}
+		request.DataCoding = DetectDataCoding(request.Body)
+	}
 	request.ReportURL = params.ReportURL
 	request.ShortenURLs = params.ShortenURLs
 
 	return request, nil
 }
 
+// messageType returns the type of the message, as set by Type, Premium or
+// Flash.
+func (p *Params) messageType() string {
+	switch {
+	case p.Premium != nil:
+		return TypePremium
+	case p.Flash:
+		return TypeFlash
+	}
+	return p.Type
+}
+
+// validate returns an error if the params contradict each other or are
+// invalid.
+func (p *Params) validate() error {
+	if p.Premium != nil {
+		if p.Type != "" && p.Type != TypePremium {
+			return fmt.Errorf("%w: premium details can't be used for %s messages", messagebird.ErrInvalidParams, p.Type)
+		}
+		if p.Flash {
+			return fmt.Errorf("%w: flash can't be used for premium messages", messagebird.ErrInvalidParams)
+		}
+		if err := p.Premium.validate(); err != nil {
+			return err
+		}
+	} else if p.Type == TypePremium {
+		if err := validatePremiumTypeDetails(p.TypeDetails); err != nil {
+			return err
+		}
+	}
+
+	if p.Flash && p.Type != "" && p.Type != TypeSMS && p.Type != TypeFlash {
+		return fmt.Errorf("%w: flash can't be used for %s messages", messagebird.ErrInvalidParams, p.Type)
+	}
+	if p.messageType() == TypeFlash {
+		switch p.DataCoding {
+		case "", DataCodingPlain, DataCodingUnicode, DataCodingAuto:
+		default:
+			return fmt.Errorf("%w: datacoding %q can't be used for flash messages", messagebird.ErrInvalidParams, p.DataCoding)
+		}
+	}
+
+	if p.Validity < 0 {
+		return fmt.Errorf("%w: validity of %d seconds must not be negative", messagebird.ErrInvalidParams, p.Validity)
+	}
+	if p.Gateway < 0 {
+		return fmt.Errorf("%w: gateway %d must not be negative", messagebird.ErrInvalidParams, p.Gateway)
+	}
+
+	return validateReportURL(p.ReportURL)
+}
+
+// validateReportURL returns an error if reportURL is set, but isn't an
+// absolute http or https URL.
+func validateReportURL(reportURL string) error {
+	if reportURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(reportURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: report URL %q must be an absolute http or https URL", messagebird.ErrInvalidParams, reportURL)
+	}
+
+	return nil
+}
+
 // paramsForMessageList converts the specified MessageListParams struct to a
 // url.Values pointer and returns it.
 func paramsForMessageList(params *ListParams) (*url.Values, error) {
@@ -194,9 +334,21 @@ func paramsForMessageList(params *ListParams) (*url.Values, error) {
 	if params.Originator != "" {
 		urlParams.Set("originator", params.Originator)
 	}
+	if params.Type != "" {
+		urlParams.Set("type", params.Type)
+	}
 	if params.Status != "" {
 		urlParams.Set("status", params.Status)
 	}
+	if params.Reference != "" {
+		urlParams.Set("reference", params.Reference)
+	}
+	if !params.From.IsZero() {
+		urlParams.Set("from", params.From.Format(time.RFC3339))
+	}
+	if !params.Until.IsZero() {
+		urlParams.Set("until", params.Until.Format(time.RFC3339))
+	}
 	if params.Limit != 0 {
 		urlParams.Set("limit", strconv.Itoa(params.Limit))
 	}
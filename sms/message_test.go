@@ -1,6 +1,7 @@
 package sms
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 	"testing"
@@ -128,6 +129,24 @@ func TestCreateWithFlashType(t *testing.T) {
 	message, err := Create(client, "TestName", []string{"31612345678"}, "Hello World", params)
 	assert.NoError(t, err)
 	assert.Equal(t, "flash", message.Type)
+	assert.Contains(t, string(mbtest.Request.Body), `"mclass":0`)
+}
+
+func TestRequestDataForMessageFlash(t *testing.T) {
+	request, err := requestDataForMessage("TestName", []string{"31612345678"}, "Alert", &Params{Flash: true, DataCoding: DataCodingUnicode})
+	assert.NoError(t, err)
+	assert.Equal(t, TypeFlash, request.Type)
+	assert.Equal(t, 0, *request.MClass)
+
+	_, err = requestDataForMessage("TestName", []string{"31612345678"}, "Alert", &Params{Flash: true, Type: TypeBinary})
+	assert.EqualError(t, err, "invalid or missing parameters: flash can't be used for binary messages")
+
+	_, err = requestDataForMessage("TestName", []string{"31612345678"}, "Alert", &Params{Type: TypeFlash, DataCoding: "binary"})
+	assert.EqualError(t, err, `invalid or missing parameters: datacoding "binary" can't be used for flash messages`)
+
+	request, err = requestDataForMessage("TestName", []string{"31612345678"}, "Hello", &Params{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *request.MClass)
 }
 
 func TestCreateWithScheduledDatetime(t *testing.T) {
@@ -148,6 +167,16 @@ func TestCreateWithScheduledDatetime(t *testing.T) {
 	assert.Nil(t, message.Recipients.Items[0].StatusDatetime)
 }
 
+func TestDelete(t *testing.T) {
+	mbtest.WillReturn([]byte(""), http.StatusNoContent)
+	client := mbtest.Client(t)
+
+	_, err := Delete(client, "6fe65f90454aa61536e6a88b88972670")
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodDelete, "/messages/6fe65f90454aa61536e6a88b88972670")
+}
+
 func TestList(t *testing.T) {
 	mbtest.WillReturnTestdata(t, "messageListObject.json", http.StatusOK)
 	client := mbtest.Client(t)
@@ -164,6 +193,36 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListWithParams(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageListObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := List(client, &ListParams{
+		Originator: "TestName",
+		Direction:  "mt",
+		Type:       "sms",
+		Status:     "delivered",
+		Reference:  "order-1234",
+		From:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		Until:      time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		Limit:      50,
+		Offset:     100,
+	})
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/messages")
+	query := mbtest.Request.URL.Query()
+	assert.Equal(t, "TestName", query.Get("originator"))
+	assert.Equal(t, "mt", query.Get("direction"))
+	assert.Equal(t, "sms", query.Get("type"))
+	assert.Equal(t, "delivered", query.Get("status"))
+	assert.Equal(t, "order-1234", query.Get("reference"))
+	assert.Equal(t, "2021-03-01T00:00:00Z", query.Get("from"))
+	assert.Equal(t, "2021-04-01T00:00:00Z", query.Get("until"))
+	assert.Equal(t, "50", query.Get("limit"))
+	assert.Equal(t, "100", query.Get("offset"))
+}
+
 func TestListScheduled(t *testing.T) {
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		expectedStatusFilter := "status=scheduled"
@@ -213,3 +272,99 @@ func TestRequestDataForMessage(t *testing.T) {
 	assert.Equal(t, messageParams.ScheduledDatetime.Format(time.RFC3339), request.ScheduledDatetime)
 	assert.True(t, request.ShortenURLs)
 }
+
+func TestRequestDataForMessageInvalidOriginator(t *testing.T) {
+	_, err := requestDataForMessage("MessageBirdBV", []string{"31612345678"}, "MyBody", nil)
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+}
+
+func TestRequestDataForMessageValidityAndGateway(t *testing.T) {
+	request, err := requestDataForMessage("TestName", []string{"31612345678"}, "Your code is 123456", &Params{
+		Validity: ValiditySeconds(90 * time.Second),
+		Gateway:  10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 90, request.Validity)
+	assert.Equal(t, 10, request.Gateway)
+
+	_, err = requestDataForMessage("TestName", []string{"31612345678"}, "Hello", &Params{Validity: -1})
+	assert.EqualError(t, err, "invalid or missing parameters: validity of -1 seconds must not be negative")
+
+	_, err = requestDataForMessage("TestName", []string{"31612345678"}, "Hello", &Params{Gateway: -1})
+	assert.EqualError(t, err, "invalid or missing parameters: gateway -1 must not be negative")
+	assert.ErrorIs(t, err, messagebird.ErrInvalidParams)
+}
+
+func TestValiditySeconds(t *testing.T) {
+	assert.Equal(t, 60, ValiditySeconds(time.Minute))
+	assert.Equal(t, 2, ValiditySeconds(1500*time.Millisecond))
+	assert.Equal(t, 0, ValiditySeconds(0))
+}
+
+func TestCreateWithGroupIDs(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := Create(client, "TestName", nil, "Hello World", &Params{GroupIDs: []string{"group-id"}})
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/messages")
+	assert.JSONEq(t, `{
+		"originator": "TestName",
+		"body": "Hello World",
+		"groupIds": ["group-id"],
+		"mclass": 1,
+		"shortenUrls": false
+	}`, string(mbtest.Request.Body))
+
+	_, err = requestDataForMessage("TestName", nil, "Hello World", nil)
+	assert.EqualError(t, err, "invalid or missing parameters: at least 1 recipient or group is required")
+}
+
+func TestRequestDataForMessageReportURL(t *testing.T) {
+	request, err := requestDataForMessage("TestName", []string{"31612345678"}, "Hello World", &Params{
+		ReportURL: "https://tenant.example.com/reports",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://tenant.example.com/reports", request.ReportURL)
+
+	for _, reportURL := range []string{"/reports", "ftp://example.com/reports", "https://"} {
+		_, err := requestDataForMessage("TestName", []string{"31612345678"}, "Hello World", &Params{ReportURL: reportURL})
+		assert.Error(t, err, reportURL)
+	}
+}
+
+func TestRequestDataForMessageShortenURLs(t *testing.T) {
+	request, err := requestDataForMessage("TestName", []string{"31612345678"}, "Sale: https://example.com/sale", &Params{ShortenURLs: true})
+	assert.NoError(t, err)
+	assert.True(t, request.ShortenURLs)
+}
+
+func TestReadWithRecipientDetails(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObjectWithRecipientDetails.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	message, err := Read(client, "6fe65f90454aa61536e6a88b88972670")
+	assert.NoError(t, err)
+	assert.Len(t, message.Recipients.Items, 2)
+
+	delivered := message.Recipients.Items[0]
+	assert.True(t, delivered.IsDelivered())
+	assert.Equal(t, "2015-01-05T10:03:10Z", delivered.StatusDatetime.Format(time.RFC3339))
+	assert.Nil(t, delivered.StatusErrorCode)
+	assert.Equal(t, "Netherlands", delivered.RecipientCountry)
+	assert.Equal(t, 31, delivered.RecipientCountryPrefix)
+	assert.Equal(t, "KPN", delivered.RecipientOperator)
+	assert.Equal(t, "20408", delivered.MCCMNC)
+	assert.Equal(t, 11, delivered.MessageLength)
+	assert.Equal(t, 1, delivered.MessagePartCount)
+	assert.Equal(t, &Price{Amount: "0.07", Currency: "EUR"}, delivered.Price)
+
+	failed := message.Recipients.Items[1]
+	assert.True(t, failed.IsFailed())
+	assert.Equal(t, "unknown subscriber", failed.StatusReason)
+	if assert.NotNil(t, failed.StatusErrorCode) {
+		assert.Equal(t, 1, *failed.StatusErrorCode)
+	}
+	assert.Nil(t, failed.Price)
+}
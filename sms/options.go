@@ -0,0 +1,126 @@
+package sms
+
+import (
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// Option configures a message created with CreateWithOptions, or the Params
+// built with NewParams.
+type Option func(*Params)
+
+// WithType sets the type of the message, e.g. TypeBinary. The default is
+// TypeSMS.
+func WithType(messageType string) Option {
+	return func(p *Params) { p.Type = messageType }
+}
+
+// WithTypeDetails sets a type detail, e.g. the udh of binary messages.
+func WithTypeDetails(key string, value interface{}) Option {
+	return func(p *Params) {
+		if p.TypeDetails == nil {
+			p.TypeDetails = TypeDetails{}
+		}
+		p.TypeDetails[key] = value
+	}
+}
+
+// WithReference sets a client reference, e.g. an order ID.
+func WithReference(reference string) Option {
+	return func(p *Params) { p.Reference = reference }
+}
+
+// WithValidity sets how long the message may be delivered in. It is rounded up
+// to whole seconds.
+func WithValidity(validity time.Duration) Option {
+	return func(p *Params) { p.Validity = ValiditySeconds(validity) }
+}
+
+// WithGateway sets the ID of the route the message is sent through.
+func WithGateway(gateway int) Option {
+	return func(p *Params) { p.Gateway = gateway }
+}
+
+// WithDataCoding sets the encoding of the message, e.g. DataCodingAuto.
+func WithDataCoding(dataCoding string) Option {
+	return func(p *Params) { p.DataCoding = dataCoding }
+}
+
+// WithTransliteration sets DataCoding to DataCodingAuto and replaces
+// characters outside the GSM 7-bit alphabet with lookalikes.
+func WithTransliteration() Option {
+	return func(p *Params) {
+		p.DataCoding = DataCodingAuto
+		p.Transliterate = true
+	}
+}
+
+// WithReportURL sets the URL status reports of the message are sent to.
+func WithReportURL(reportURL string) Option {
+	return func(p *Params) { p.ReportURL = reportURL }
+}
+
+// WithScheduledDatetime schedules the message to be sent at scheduled.
+func WithScheduledDatetime(scheduled time.Time) Option {
+	return func(p *Params) { p.ScheduledDatetime = scheduled }
+}
+
+// WithShortenURLs replaces the URLs in the body with short links that track
+// clicks.
+func WithShortenURLs() Option {
+	return func(p *Params) { p.ShortenURLs = true }
+}
+
+// WithFlash sends the message as a flash message.
+func WithFlash() Option {
+	return func(p *Params) { p.Flash = true }
+}
+
+// WithPremium sends the message as a premium-rate message.
+func WithPremium(premium *Premium) Option {
+	return func(p *Params) { p.Premium = premium }
+}
+
+// WithGroupIDs sends the message to the contacts of the given groups.
+func WithGroupIDs(groupIDs ...string) Option {
+	return func(p *Params) { p.GroupIDs = append(p.GroupIDs, groupIDs...) }
+}
+
+// NewParams builds Params from opts. It returns an error if options
+// contradict each other, e.g. WithFlash and WithPremium, or are invalid,
+// including a scheduled datetime that isn't in the future.
+func NewParams(opts ...Option) (*Params, error) {
+	params := &Params{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	if !params.ScheduledDatetime.IsZero() {
+		if err := ValidateScheduledDatetime(params.ScheduledDatetime, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+// CreateWithOptions creates a new message like Create, with Params built from
+// opts by NewParams.
+//
+//	message, err := sms.CreateWithOptions(client, "MessageBird", []string{"31612345678"}, "Your order has shipped",
+//		sms.WithReference("order-1234"),
+//		sms.WithScheduledDatetime(time.Now().Add(time.Hour)),
+//		sms.WithDataCoding(sms.DataCodingAuto),
+//	)
+func CreateWithOptions(c messagebird.Requester, originator string, recipients []string, body string, opts ...Option) (*Message, error) {
+	params, err := NewParams(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Create(c, originator, recipients, body, params)
+}
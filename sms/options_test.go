@@ -0,0 +1,82 @@
+package sms
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWithOptions(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	scheduled := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	message, err := CreateWithOptions(client, "TestName", []string{"31612345678"}, "Hello World",
+		WithReference("order-1234"),
+		WithValidity(90*time.Second),
+		WithGateway(10),
+		WithDataCoding(DataCodingUnicode),
+		WithReportURL("https://example.com/reports"),
+		WithScheduledDatetime(scheduled),
+	)
+	assert.NoError(t, err)
+	assertMessageObject(t, message)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/messages")
+	assert.JSONEq(t, `{
+		"originator": "TestName",
+		"body": "Hello World",
+		"recipients": ["31612345678"],
+		"reference": "order-1234",
+		"validity": 90,
+		"gateway": 10,
+		"datacoding": "unicode",
+		"mclass": 1,
+		"reportUrl": "https://example.com/reports",
+		"scheduledDatetime": "`+scheduled.Format(time.RFC3339)+`",
+		"shortenUrls": false
+	}`, string(mbtest.Request.Body))
+}
+
+func TestNewParams(t *testing.T) {
+	params, err := NewParams(
+		WithType(TypeBinary),
+		WithTypeDetails("udh", "050003340201"),
+		WithGroupIDs("group-1", "group-2"),
+		WithShortenURLs(),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, &Params{
+		Type:        TypeBinary,
+		TypeDetails: TypeDetails{"udh": "050003340201"},
+		GroupIDs:    []string{"group-1", "group-2"},
+		ShortenURLs: true,
+	}, params)
+
+	params, err = NewParams(WithTransliteration(), WithFlash())
+	assert.NoError(t, err)
+	assert.Equal(t, DataCodingAuto, params.DataCoding)
+	assert.True(t, params.Transliterate)
+}
+
+func TestNewParamsInvalid(t *testing.T) {
+	tt := []struct {
+		name string
+		opts []Option
+	}{
+		{"flash and premium", []Option{WithFlash(), WithPremium(&Premium{Shortcode: 1008, Keyword: "RESTAPI", Tariff: 150})}},
+		{"flash binary", []Option{WithFlash(), WithType(TypeBinary)}},
+		{"premium without details", []Option{WithType(TypePremium)}},
+		{"negative gateway", []Option{WithGateway(-1)}},
+		{"relative report URL", []Option{WithReportURL("/reports")}},
+		{"scheduled in past", []Option{WithScheduledDatetime(time.Now().Add(-time.Minute))}},
+	}
+
+	for _, tc := range tt {
+		_, err := NewParams(tc.opts...)
+		assert.Error(t, err, tc.name)
+	}
+}
@@ -0,0 +1,64 @@
+package sms
+
+import (
+	"fmt"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// Premium holds the details of a premium-rate message, for which the
+// recipient is charged.
+type Premium struct {
+	Shortcode int    // The shortcode the message is sent from.
+	Keyword   string // The keyword the recipient subscribed with.
+	Tariff    int    // The price the recipient is charged, in cents.
+
+	// MID is the ID of the mobile-originated message the message replies
+	// to. Required by some networks.
+	MID string
+
+	// Member is true if the recipient is a member of a subscription, rather
+	// than buying single messages.
+	Member bool
+}
+
+// typeDetails returns p as the TypeDetails of a premium message.
+func (p *Premium) typeDetails() TypeDetails {
+	typeDetails := TypeDetails{
+		"shortcode": p.Shortcode,
+		"keyword":   p.Keyword,
+		"tariff":    p.Tariff,
+	}
+	if p.MID != "" {
+		typeDetails["mid"] = p.MID
+	}
+	if p.Member {
+		typeDetails["member"] = true
+	}
+	return typeDetails
+}
+
+// validate checks that p has the fields the API requires.
+func (p *Premium) validate() error {
+	if p.Shortcode <= 0 {
+		return fmt.Errorf("%w: premium shortcode is required", messagebird.ErrInvalidParams)
+	}
+	if p.Keyword == "" {
+		return fmt.Errorf("%w: premium keyword is required", messagebird.ErrInvalidParams)
+	}
+	if p.Tariff <= 0 {
+		return fmt.Errorf("%w: premium tariff %d must be a positive number of cents", messagebird.ErrInvalidParams, p.Tariff)
+	}
+	return nil
+}
+
+// validatePremiumTypeDetails checks that typeDetails, as set directly on Params
+// for a premium message, has the fields the API requires.
+func validatePremiumTypeDetails(typeDetails TypeDetails) error {
+	for _, key := range []string{"shortcode", "keyword", "tariff"} {
+		if _, ok := typeDetails[key]; !ok {
+			return fmt.Errorf("%w: typeDetails %s is required for premium messages", messagebird.ErrInvalidParams, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package sms
+
+import (
+	"net/http"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWithPremium(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "premiumMessageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := Create(client, "TestName", []string{"31612345678"}, "Hello World", &Params{
+		Premium: &Premium{Shortcode: 1008, Keyword: "RESTAPI", Tariff: 150, MID: "mo-id"},
+	})
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"originator": "TestName",
+		"body": "Hello World",
+		"recipients": ["31612345678"],
+		"type": "premium",
+		"typeDetails": {"shortcode": 1008, "keyword": "RESTAPI", "tariff": 150, "mid": "mo-id"},
+		"mclass": 1,
+		"shortenUrls": false
+	}`, string(mbtest.Request.Body))
+}
+
+func TestPremiumValidation(t *testing.T) {
+	tt := []struct {
+		name    string
+		params  *Params
+		wantErr string
+	}{
+		{"missing shortcode", &Params{Premium: &Premium{Keyword: "RESTAPI", Tariff: 150}}, "invalid or missing parameters: premium shortcode is required"},
+		{"missing keyword", &Params{Premium: &Premium{Shortcode: 1008, Tariff: 150}}, "invalid or missing parameters: premium keyword is required"},
+		{"invalid tariff", &Params{Premium: &Premium{Shortcode: 1008, Keyword: "RESTAPI"}}, "invalid or missing parameters: premium tariff 0 must be a positive number of cents"},
+		{"other type", &Params{Type: TypeFlash, Premium: &Premium{Shortcode: 1008, Keyword: "RESTAPI", Tariff: 150}}, "invalid or missing parameters: premium details can't be used for flash messages"},
+		{"type details", &Params{Type: TypePremium, TypeDetails: TypeDetails{"shortcode": 1008, "keyword": "RESTAPI"}}, "invalid or missing parameters: typeDetails tariff is required for premium messages"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := requestDataForMessage("TestName", []string{"31612345678"}, "Hello World", tc.params)
+			assert.EqualError(t, err, tc.wantErr)
+			assert.ErrorIs(t, err, messagebird.ErrInvalidParams)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package sms
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/webhook"
+	"github.com/messagebird/go-rest-api/v7/signature"
+)
+
+// Report is a status report (DLR) of a message to one recipient, as sent to
+// the ReportURL of the message whenever the recipient's status changes.
+type Report struct {
+	ID               string // ID of the message.
+	Reference        string
+	Recipient        string
+	Status           string // One of the messagebird.RecipientStatus constants.
+	StatusReason     string
+	StatusErrorCode  int
+	StatusDatetime   time.Time
+	MCCMNC           string // Mobile country and network code of the recipient.
+	Ported           bool   // Whether the recipient's number was ported to another network.
+	MessagePartCount int
+	Price            Price
+}
+
+// Price is the price of a message.
+type Price = messagebird.Price
+
+// ParseReport parses and validates the status report in r. Reports are sent
+// as query parameters, but form-encoded POST bodies are accepted too.
+func ParseReport(r *http.Request) (*Report, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid report: %w", err)
+	}
+	form := r.Form
+
+	report := &Report{
+		ID:           form.Get("id"),
+		Reference:    form.Get("reference"),
+		Recipient:    form.Get("recipient"),
+		Status:       form.Get("status"),
+		StatusReason: form.Get("statusReason"),
+		MCCMNC:       form.Get("mccmnc"),
+		Ported:       form.Get("ported") == "1" || form.Get("ported") == "true",
+		Price: Price{
+			Currency: form.Get("price[currency]"),
+		},
+	}
+
+	if report.ID == "" {
+		return nil, errors.New("invalid report: id is missing")
+	}
+	if report.Recipient == "" {
+		return nil, errors.New("invalid report: recipient is missing")
+	}
+	if report.Status == "" {
+		return nil, errors.New("invalid report: status is missing")
+	}
+
+	var err error
+	if v := form.Get("statusErrorCode"); v != "" {
+		if report.StatusErrorCode, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("invalid report: statusErrorCode: %w", err)
+		}
+	}
+	if v := form.Get("messagePartCount"); v != "" {
+		if report.MessagePartCount, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("invalid report: messagePartCount: %w", err)
+		}
+	}
+	if v := form.Get("price[amount]"); v != "" {
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("invalid report: price[amount]: %w", err)
+		}
+		report.Price.Amount = json.Number(v)
+	}
+	if v := form.Get("statusDatetime"); v != "" {
+		if report.StatusDatetime, err = time.Parse(time.RFC3339, v); err != nil {
+			return nil, fmt.Errorf("invalid report: statusDatetime: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+// IsDelivered reports whether the message was delivered to the recipient.
+func (r *Report) IsDelivered() bool {
+	return r.Status == messagebird.RecipientStatusDelivered
+}
+
+// ReportHandler is an http.Handler for status reports, that calls the
+// callback registered for the status of the report:
+//
+//	h := sms.NewReportHandler(signature.NewValidator(signingKey))
+//	h.Handle(messagebird.RecipientStatusDelivered, markDelivered)
+//	h.Handle(messagebird.RecipientStatusDeliveryFailed, retry)
+//	http.Handle("/reports", h)
+//
+// Requests with an invalid signature are rejected with 401 Unauthorized, and
+// invalid reports with 400 Bad Request.
+type ReportHandler struct {
+	handler http.Handler
+
+	mu        sync.RWMutex
+	callbacks map[string]func(*Report)
+	fallback  func(*Report)
+}
+
+// NewReportHandler returns a ReportHandler that checks the signature of
+// requests with validator. If validator is nil, signatures are not checked.
+func NewReportHandler(validator *signature.Validator) *ReportHandler {
+	h := &ReportHandler{callbacks: make(map[string]func(*Report))}
+	h.handler = webhook.Handler(validator, ParseReport, h.dispatch)
+	return h
+}
+
+// Handle registers fn to be called for reports with the given status.
+func (h *ReportHandler) Handle(status string, fn func(*Report)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks[status] = fn
+}
+
+// HandleDefault registers fn to be called for reports with a status no
+// callback was registered for. Reports are ignored if there is none.
+func (h *ReportHandler) HandleDefault(fn func(*Report)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+// ServeHTTP implements http.Handler.
+func (h *ReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+// dispatch calls the callback registered for the status of report.
+func (h *ReportHandler) dispatch(report *Report) {
+	h.mu.RLock()
+	fn, ok := h.callbacks[report.Status]
+	if !ok {
+		fn = h.fallback
+	}
+	h.mu.RUnlock()
+
+	if fn != nil {
+		fn(report)
+	}
+}
@@ -0,0 +1,101 @@
+package sms
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+const testReportQuery = "id=6fe65f90454aa61536e6a88b88972670&mccmnc=20408&messagePartCount=1&ported=0&price%5Bamount%5D=0.07&price%5Bcurrency%5D=EUR&recipient=31612345678&reference=MyReference&status=delivered&statusDatetime=2021-03-01T10%3A00%3A05%2B00%3A00"
+
+func TestParseReport(t *testing.T) {
+	report, err := ParseReport(httptest.NewRequest(http.MethodGet, "/reports?"+testReportQuery, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "6fe65f90454aa61536e6a88b88972670", report.ID)
+	assert.Equal(t, "MyReference", report.Reference)
+	assert.Equal(t, "31612345678", report.Recipient)
+	assert.True(t, report.IsDelivered())
+	assert.Equal(t, "20408", report.MCCMNC)
+	assert.False(t, report.Ported)
+	assert.Equal(t, 1, report.MessagePartCount)
+	assert.Equal(t, Price{Amount: "0.07", Currency: "EUR"}, report.Price)
+	assert.Equal(t, "2021-03-01T10:00:05Z", report.StatusDatetime.UTC().Format(time.RFC3339))
+}
+
+func TestParseReportFailed(t *testing.T) {
+	report, err := ParseReport(httptest.NewRequest(http.MethodGet, "/reports?id=id&recipient=31612345678&status=delivery_failed&statusReason=unknown+subscriber&statusErrorCode=1", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, messagebird.RecipientStatusDeliveryFailed, report.Status)
+	assert.Equal(t, "unknown subscriber", report.StatusReason)
+	assert.Equal(t, 1, report.StatusErrorCode)
+}
+
+func TestParseReportInvalid(t *testing.T) {
+	for _, query := range []string{
+		"recipient=31612345678&status=sent",
+		"id=id&status=sent",
+		"id=id&recipient=31612345678",
+		"id=id&recipient=31612345678&status=sent&statusErrorCode=x",
+		"id=id&recipient=31612345678&status=sent&statusDatetime=yesterday",
+	} {
+		_, err := ParseReport(httptest.NewRequest(http.MethodGet, "/reports?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+// signedRequest returns a GET request with query, signed with key.
+func signedRequest(key, query string) *http.Request {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	bodyHash := sha256.Sum256(nil)
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(ts + "\n" + query + "\n" + string(bodyHash[:])))
+
+	r := httptest.NewRequest(http.MethodGet, "/reports?"+query, nil)
+	r.Header.Set("MessageBird-Request-Timestamp", ts)
+	r.Header.Set("MessageBird-Signature", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return r
+}
+
+func TestReportHandler(t *testing.T) {
+	var delivered, other []*Report
+	h := NewReportHandler(signature.NewValidator("secret"))
+	h.Handle(messagebird.RecipientStatusDelivered, func(r *Report) { delivered = append(delivered, r) })
+	h.HandleDefault(func(r *Report) { other = append(other, r) })
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", testReportQuery))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", "id=id&recipient=31612345678&status=buffered"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("other-secret", testReportQuery))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedRequest("secret", "id=id"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Len(t, delivered, 1)
+	assert.Len(t, other, 1)
+	assert.Equal(t, messagebird.RecipientStatusBuffered, other[0].Status)
+}
+
+func TestReportHandlerWithoutValidator(t *testing.T) {
+	h := NewReportHandler(nil)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports?"+testReportQuery, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
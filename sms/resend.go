@@ -0,0 +1,47 @@
+package sms
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// ErrNothingToResend is returned by Resend when none of the recipients of the
+// message has one of the statuses to resend to.
+var ErrNothingToResend = errors.New("no recipients to resend to")
+
+// Resend sends the message with the given ID again, with the same originator,
+// body and params, to the recipients that have one of the given statuses. If
+// no statuses are given, it resends to the recipients whose status is
+// messagebird.RecipientStatusDeliveryFailed. It returns an error matching
+// ErrNothingToResend if no recipient has any of the statuses.
+//
+//	// Resend to recipients the message failed or expired for.
+//	message, err := sms.Resend(client, id, messagebird.RecipientStatusDeliveryFailed, messagebird.RecipientStatusExpired)
+func Resend(c messagebird.Requester, id string, statuses ...string) (*Message, error) {
+	if len(statuses) == 0 {
+		statuses = []string{messagebird.RecipientStatusDeliveryFailed}
+	}
+
+	message, err := Read(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipients []string
+	for _, recipient := range message.Recipients.Items {
+		for _, status := range statuses {
+			if recipient.Status == status {
+				recipients = append(recipients, strconv.FormatInt(recipient.Recipient, 10))
+				break
+			}
+		}
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNothingToResend, id)
+	}
+
+	return Create(c, message.Originator, recipients, message.Body, paramsForMessage(message))
+}
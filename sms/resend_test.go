@@ -0,0 +1,51 @@
+package sms
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResend(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObjectWithRecipientDetails.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := Resend(client, "6fe65f90454aa61536e6a88b88972670")
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/messages")
+	assert.JSONEq(t, `{
+		"originator": "TestName",
+		"body": "Hello World",
+		"recipients": ["31612345679"],
+		"type": "sms",
+		"gateway": 239,
+		"datacoding": "plain",
+		"mclass": 1,
+		"shortenUrls": false
+	}`, string(mbtest.Request.Body))
+}
+
+func TestResendStatuses(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObjectWithRecipientDetails.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := Resend(client, "6fe65f90454aa61536e6a88b88972670", messagebird.RecipientStatusDelivered, messagebird.RecipientStatusDeliveryFailed)
+	assert.NoError(t, err)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/messages")
+	assert.Contains(t, string(mbtest.Request.Body), `"recipients":["31612345678","31612345679"]`)
+}
+
+func TestResendNothingToResend(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := Resend(client, "6fe65f90454aa61536e6a88b88972670")
+	assert.True(t, errors.Is(err, ErrNothingToResend))
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/messages/6fe65f90454aa61536e6a88b88972670")
+}
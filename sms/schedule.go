@@ -0,0 +1,202 @@
+package sms
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// StatusScheduled is the status of messages that are scheduled to be sent
+// later.
+const StatusScheduled = messagebird.RecipientStatusScheduled
+
+// ErrScheduledInPast is returned by ValidateScheduledDatetime for times that
+// are not in the future.
+var ErrScheduledInPast = errors.New("scheduled datetime is not in the future")
+
+// ErrNotScheduled is returned by Cancel and Reschedule for messages that are
+// not scheduled (anymore), e.g. because they were already sent.
+var ErrNotScheduled = errors.New("message is not scheduled")
+
+// ListScheduled retrieves the messages that are scheduled to be sent, i.e. List
+// with the StatusScheduled filter. params may be nil.
+func ListScheduled(c messagebird.Requester, params *ListParams) (*MessageList, error) {
+	scheduledParams := &ListParams{}
+	if params != nil {
+		*scheduledParams = *params
+	}
+	scheduledParams.Status = StatusScheduled
+
+	return List(c, scheduledParams)
+}
+
+// Cancel cancels sending the scheduled message with the given ID. It returns
+// an error matching ErrNotScheduled if the message already left the queue.
+func Cancel(c messagebird.Requester, id string) error {
+	if _, err := readScheduled(c, id); err != nil {
+		return err
+	}
+
+	_, err := Delete(c, id)
+	return err
+}
+
+// Reschedule changes the time the scheduled message with the given ID is
+// sent. As the API does not support updating messages, the message is
+// created again with the same originator, recipients, body and params, and
+// the original is cancelled afterwards, so the returned Message has a new ID.
+// ShortenURLs and GroupIDs are not returned by the API and can't be carried
+// over: the message is sent to the recipients it was expanded to, with URLs
+// as they appear in its body.
+//
+// It returns an error matching ErrScheduledInPast if scheduled is not in the
+// future, and an error matching ErrNotScheduled if the message already left
+// the queue. If the original message could not be cancelled, the
+// rescheduled message is returned along with the error, so both are
+// scheduled until the original is cancelled with Cancel.
+func Reschedule(c messagebird.Requester, id string, scheduled time.Time) (*Message, error) {
+	if err := ValidateScheduledDatetime(scheduled, time.Now()); err != nil {
+		return nil, err
+	}
+
+	message, err := readScheduled(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]string, len(message.Recipients.Items))
+	for i, recipient := range message.Recipients.Items {
+		recipients[i] = strconv.FormatInt(recipient.Recipient, 10)
+	}
+	params := paramsForMessage(message)
+	params.ScheduledDatetime = scheduled
+
+	requestData, err := requestDataForMessage(message.Originator, recipients, message.Body, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rescheduled := &Message{}
+	if err := c.Request(rescheduled, http.MethodPost, path, requestData); err != nil {
+		return nil, createError(err)
+	}
+
+	if _, err := Delete(c, id); err != nil {
+		return rescheduled, fmt.Errorf("rescheduled as %s, but cancelling %s failed: %w", rescheduled.ID, id, err)
+	}
+
+	return rescheduled, nil
+}
+
+// paramsForMessage returns the params to create message again with. The API
+// doesn't return ShortenURLs and GroupIDs of messages, so they are not set.
+func paramsForMessage(message *Message) *Params {
+	params := &Params{
+		Type:        message.Type,
+		Reference:   message.Reference,
+		Gateway:     message.Gateway,
+		TypeDetails: message.TypeDetails,
+		DataCoding:  message.DataCoding,
+		ReportURL:   message.ReportURL,
+	}
+	if message.Validity != nil {
+		params.Validity = *message.Validity
+	}
+	return params
+}
+
+// readScheduled reads the message with the given ID, and returns an error
+// matching ErrNotScheduled if none of its recipients is scheduled.
+func readScheduled(c messagebird.Requester, id string) (*Message, error) {
+	message, err := Read(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recipient := range message.Recipients.Items {
+		if recipient.Status == StatusScheduled {
+			return message, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotScheduled, id)
+}
+
+// ValidateScheduledDatetime checks that scheduled, as used for
+// Params.ScheduledDatetime, is after now. The API sends messages scheduled
+// in the past immediately.
+func ValidateScheduledDatetime(scheduled, now time.Time) error {
+	if !scheduled.After(now) {
+		return fmt.Errorf("%w: %s", ErrScheduledInPast, scheduled.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ScheduleWindow is the time of day, and optionally the days of the week, in
+// which messages may be sent, e.g. to avoid messaging customers at night:
+//
+//	w := sms.ScheduleWindow{Start: 9 * time.Hour, End: 21 * time.Hour, Location: loc}
+//	params := &sms.Params{ScheduledDatetime: w.Next(time.Now())}
+type ScheduleWindow struct {
+	Start time.Duration // Offset from midnight at which the window opens.
+	End   time.Duration // Offset from midnight at which the window closes.
+
+	// Days are the days of the week the window is open. It is open every day
+	// if Days is empty.
+	Days []time.Weekday
+
+	// Location is the time zone of Start, End and Days. It defaults to UTC.
+	Location *time.Location
+}
+
+// Contains reports whether t is in the window.
+func (w ScheduleWindow) Contains(t time.Time) bool {
+	t = t.In(w.location())
+	hour, min, sec := t.Clock()
+	offset := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+
+	return w.openOn(t.Weekday()) && offset >= w.Start && offset < w.End
+}
+
+// Next returns t if it is in the window, or otherwise the time the window opens
+// next. It returns the zero time if the window never opens.
+func (w ScheduleWindow) Next(t time.Time) time.Time {
+	if w.Start < 0 || w.End <= w.Start || w.End > 24*time.Hour {
+		return time.Time{}
+	}
+	if w.Contains(t) {
+		return t
+	}
+
+	t = t.In(w.location())
+	for day := 0; day <= 7; day++ {
+		date := t.AddDate(0, 0, day)
+		start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, t.Location()).Add(w.Start)
+		if start.After(t) && w.openOn(start.Weekday()) {
+			return start
+		}
+	}
+	return time.Time{}
+}
+
+func (w ScheduleWindow) openOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (w ScheduleWindow) location() *time.Location {
+	if w.Location == nil {
+		return time.UTC
+	}
+	return w.Location
+}
@@ -0,0 +1,175 @@
+package sms
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListScheduledStatus(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageListScheduledObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	messageList, err := ListScheduled(client, &ListParams{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, messageList.TotalCount)
+
+	query := mbtest.Request.URL.Query()
+	assert.Equal(t, StatusScheduled, query.Get("status"))
+	assert.Equal(t, "10", query.Get("limit"))
+}
+
+func TestValidateScheduledDatetime(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, ValidateScheduledDatetime(now.Add(time.Minute), now))
+
+	err := ValidateScheduledDatetime(now, now)
+	assert.True(t, errors.Is(err, ErrScheduledInPast))
+	assert.EqualError(t, err, "scheduled datetime is not in the future: 2021-03-01T12:00:00Z")
+}
+
+func TestScheduleWindow(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	w := ScheduleWindow{
+		Start:    9 * time.Hour,
+		End:      21 * time.Hour,
+		Days:     []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		Location: amsterdam,
+	}
+
+	// Monday 1 March 2021, 12:00 in Amsterdam.
+	monday := time.Date(2021, 3, 1, 11, 0, 0, 0, time.UTC)
+	assert.True(t, w.Contains(monday))
+	assert.Equal(t, monday, w.Next(monday))
+
+	// Monday at 22:00 opens on Tuesday at 09:00.
+	mondayNight := time.Date(2021, 3, 1, 22, 0, 0, 0, amsterdam)
+	assert.False(t, w.Contains(mondayNight))
+	assert.Equal(t, time.Date(2021, 3, 2, 9, 0, 0, 0, amsterdam), w.Next(mondayNight))
+
+	// Friday at 21:00 opens on Monday at 09:00.
+	friday := time.Date(2021, 3, 5, 21, 0, 0, 0, amsterdam)
+	assert.Equal(t, time.Date(2021, 3, 8, 9, 0, 0, 0, amsterdam), w.Next(friday))
+
+	// Early in the morning opens the same day.
+	early := time.Date(2021, 3, 3, 6, 30, 0, 0, amsterdam)
+	assert.Equal(t, time.Date(2021, 3, 3, 9, 0, 0, 0, amsterdam), w.Next(early))
+
+	assert.True(t, ScheduleWindow{Start: 10 * time.Hour, End: 9 * time.Hour}.Next(monday).IsZero())
+}
+
+func TestCancel(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObjectWithCreatedDatetime.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	assert.NoError(t, Cancel(client, "6fe65f90454aa61536e6a88b88972670"))
+	mbtest.AssertEndpointCalled(t, http.MethodDelete, "/messages/6fe65f90454aa61536e6a88b88972670")
+}
+
+func TestCancelNotScheduled(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "messageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	err := Cancel(client, "6fe65f90454aa61536e6a88b88972670")
+	assert.True(t, errors.Is(err, ErrNotScheduled))
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/messages/6fe65f90454aa61536e6a88b88972670")
+
+	_, err = Reschedule(client, "6fe65f90454aa61536e6a88b88972670", time.Now().Add(time.Hour))
+	assert.True(t, errors.Is(err, ErrNotScheduled))
+}
+
+// rescheduleRequester returns message for reads and records the requests
+// that reschedule it. Creating messages fails with createErr, deleting them
+// with deleteErr.
+type rescheduleRequester struct {
+	message   []byte
+	createErr error
+	deleteErr error
+	methods   []string
+	created   *messageRequest
+}
+
+func (r *rescheduleRequester) Request(v interface{}, method, path string, data interface{}) error {
+	r.methods = append(r.methods, method)
+	switch method {
+	case http.MethodPost:
+		if r.createErr != nil {
+			return r.createErr
+		}
+		r.created = data.(*messageRequest)
+		return json.Unmarshal([]byte(`{"id":"new-id"}`), v)
+	case http.MethodDelete:
+		return r.deleteErr
+	default:
+		return json.Unmarshal(r.message, v)
+	}
+}
+
+func TestReschedule(t *testing.T) {
+	requester := &rescheduleRequester{message: mbtest.Testdata(t, "messageObjectWithCreatedDatetime.json")}
+
+	scheduled := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	rescheduled, err := Reschedule(requester, "6fe65f90454aa61536e6a88b88972670", scheduled)
+	assert.NoError(t, err)
+	assert.Equal(t, "new-id", rescheduled.ID)
+
+	// The message is created again before the original is cancelled.
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost, http.MethodDelete}, requester.methods)
+
+	body, err := json.Marshal(requester.created)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"originator": "TestName",
+		"body": "Hello World",
+		"recipients": ["31612345678"],
+		"type": "sms",
+		"gateway": 239,
+		"datacoding": "plain",
+		"mclass": 1,
+		"scheduledDatetime": "`+scheduled.Format(time.RFC3339)+`",
+		"shortenUrls": false
+	}`, string(body))
+}
+
+func TestRescheduleCreateFails(t *testing.T) {
+	requester := &rescheduleRequester{
+		message:   mbtest.Testdata(t, "messageObjectWithCreatedDatetime.json"),
+		createErr: errors.New("network is down"),
+	}
+
+	_, err := Reschedule(requester, "6fe65f90454aa61536e6a88b88972670", time.Now().Add(time.Hour))
+	assert.EqualError(t, err, "network is down")
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost}, requester.methods)
+}
+
+func TestRescheduleDeleteFails(t *testing.T) {
+	requester := &rescheduleRequester{
+		message:   mbtest.Testdata(t, "messageObjectWithCreatedDatetime.json"),
+		deleteErr: errors.New("network is down"),
+	}
+
+	rescheduled, err := Reschedule(requester, "6fe65f90454aa61536e6a88b88972670", time.Now().Add(time.Hour))
+	assert.EqualError(t, err, "rescheduled as new-id, but cancelling 6fe65f90454aa61536e6a88b88972670 failed: network is down")
+	assert.Equal(t, "new-id", rescheduled.ID)
+}
+
+func TestRescheduleInPast(t *testing.T) {
+	requester := &rescheduleRequester{}
+
+	_, err := Reschedule(requester, "6fe65f90454aa61536e6a88b88972670", time.Time{})
+	assert.True(t, errors.Is(err, ErrScheduledInPast))
+
+	_, err = Reschedule(requester, "6fe65f90454aa61536e6a88b88972670", time.Now().Add(-time.Minute))
+	assert.True(t, errors.Is(err, ErrScheduledInPast))
+	assert.Empty(t, requester.methods)
+}
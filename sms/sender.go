@@ -0,0 +1,196 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// DefaultSenderRetryDelay is how long a Sender waits before retrying a
+// throttled message when the API doesn't say when to retry.
+const DefaultSenderRetryDelay = time.Second
+
+// ErrSenderClosed is returned by Sender.Send after the sender was closed.
+var ErrSenderClosed = errors.New("sender is closed")
+
+// SenderOptions configures a Sender.
+type SenderOptions struct {
+	// Workers is the number of messages that are sent concurrently. It
+	// defaults to 1.
+	Workers int
+
+	// QueueSize is the number of messages that can be queued before Send
+	// blocks. It defaults to 0, meaning Send blocks until a worker picks up
+	// the message.
+	QueueSize int
+
+	// Limiter limits the rate at which messages are sent, so the account's
+	// throughput limits are respected. Messages are not limited if nil.
+	Limiter *messagebird.RateLimiter
+
+	// MaxRetries is the number of times a message is retried when the API
+	// responds with 429 Too Many Requests.
+	MaxRetries int
+
+	// RetryDelay is how long to wait before retrying a throttled message
+	// when the API doesn't say when to retry. It defaults to
+	// DefaultSenderRetryDelay.
+	RetryDelay time.Duration
+}
+
+// A Job is a message queued on a Sender.
+type Job struct {
+	Originator string
+	Recipients []string
+	Body       string
+	Params     *Params
+
+	// Done is called from a worker with the created message or the error
+	// with which sending failed. It may be nil.
+	Done func(*Message, error)
+}
+
+type queuedJob struct {
+	ctx         context.Context
+	job         *Job
+	requestData *messageRequest
+}
+
+// A Sender queues messages and sends them from a pool of workers in the
+// background, waiting for the Limiter and retrying throttled messages. A
+// Sender is safe for concurrent use.
+//
+//	sender := sms.NewSender(client, &sms.SenderOptions{
+//		Workers: 4,
+//		Limiter: messagebird.NewRateLimiter(50, 10),
+//	})
+//	defer sender.Close()
+//
+//	err := sender.Send(ctx, &sms.Job{
+//		Originator: "MessageBird",
+//		Recipients: []string{"31612345678"},
+//		Body:       "Hello World",
+//		Done:       func(m *sms.Message, err error) { /* ... */ },
+//	})
+type Sender struct {
+	c    messagebird.Requester
+	opts SenderOptions
+
+	queue chan queuedJob
+	wg    sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewSender creates a Sender that sends messages with c, and starts its
+// workers. Close must be called to stop the workers. Options may be nil.
+func NewSender(c messagebird.Requester, opts *SenderOptions) *Sender {
+	s := &Sender{c: c}
+	if opts != nil {
+		s.opts = *opts
+	}
+	if s.opts.Workers < 1 {
+		s.opts.Workers = 1
+	}
+	if s.opts.QueueSize < 0 {
+		s.opts.QueueSize = 0
+	}
+	if s.opts.RetryDelay <= 0 {
+		s.opts.RetryDelay = DefaultSenderRetryDelay
+	}
+
+	s.queue = make(chan queuedJob, s.opts.QueueSize)
+	s.wg.Add(s.opts.Workers)
+	for i := 0; i < s.opts.Workers; i++ {
+		go s.work()
+	}
+
+	return s
+}
+
+// Send validates job and queues it, blocking while the queue is full. The
+// message is sent with ctx, so canceling ctx cancels the job. Send returns an
+// error if job is invalid, ctx is done before the job could be queued or the
+// sender is closed; errors that occur while sending are passed to job.Done.
+func (s *Sender) Send(ctx context.Context, job *Job) error {
+	if len(job.Recipients) > MaxRecipientsPerRequest {
+		return fmt.Errorf("at most %d recipients can be sent to at once, use CreateBulk for more", MaxRecipientsPerRequest)
+	}
+	requestData, err := requestDataForMessage(job.Originator, job.Recipients, job.Body, job.Params)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrSenderClosed
+	}
+
+	select {
+	case s.queue <- queuedJob{ctx: ctx, job: job, requestData: requestData}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting new messages and waits until all queued messages
+// were sent.
+func (s *Sender) Close() {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+func (s *Sender) work() {
+	defer s.wg.Done()
+
+	for queued := range s.queue {
+		message, err := s.send(queued.ctx, queued.requestData)
+		if queued.job.Done != nil {
+			queued.job.Done(message, err)
+		}
+	}
+}
+
+// send sends a message, retrying it when it's throttled.
+func (s *Sender) send(ctx context.Context, requestData *messageRequest) (*Message, error) {
+	for attempt := 0; ; attempt++ {
+		if s.opts.Limiter != nil {
+			if err := s.opts.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		message := &Message{}
+		err := messagebird.RequestContext(ctx, s.c, message, http.MethodPost, path, requestData)
+		if err == nil {
+			return message, nil
+		}
+
+		var rateLimitErr *messagebird.RateLimitError
+		if !errors.As(err, &rateLimitErr) || attempt >= s.opts.MaxRetries {
+			return nil, createError(err)
+		}
+
+		delay := time.Until(rateLimitErr.ResetTime(time.Now()))
+		if delay <= 0 {
+			delay = s.opts.RetryDelay
+		}
+		if err := messagebird.Sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
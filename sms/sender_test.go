@@ -0,0 +1,98 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// throttledRequester responds with a RateLimitError to the first throttled
+// requests, and creates a message for all other requests.
+type throttledRequester struct {
+	mu        sync.Mutex
+	throttled int
+	requests  int
+}
+
+func (r *throttledRequester) Request(v interface{}, method, path string, data interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests++
+	if r.throttled > 0 {
+		r.throttled--
+		return &messagebird.RateLimitError{RetryAfter: time.Millisecond, Err: errors.New("too many requests")}
+	}
+
+	v.(*Message).ID = "id-" + data.(*messageRequest).Recipients[0]
+	return nil
+}
+
+func TestSender(t *testing.T) {
+	requester := &throttledRequester{throttled: 2}
+	sender := NewSender(requester, &SenderOptions{Workers: 2, QueueSize: 5, MaxRetries: 2})
+
+	var mu sync.Mutex
+	ids := map[string]string{}
+	for _, recipient := range []string{"31612345671", "31612345672", "31612345673"} {
+		recipient := recipient
+		err := sender.Send(context.Background(), &Job{
+			Originator: "TestName",
+			Recipients: []string{recipient},
+			Body:       "Hello World",
+			Done: func(m *Message, err error) {
+				assert.NoError(t, err)
+				mu.Lock()
+				ids[recipient] = m.ID
+				mu.Unlock()
+			},
+		})
+		assert.NoError(t, err)
+	}
+	sender.Close()
+
+	assert.Equal(t, map[string]string{
+		"31612345671": "id-31612345671",
+		"31612345672": "id-31612345672",
+		"31612345673": "id-31612345673",
+	}, ids)
+	assert.Equal(t, 5, requester.requests)
+
+	err := sender.Send(context.Background(), &Job{Originator: "TestName", Recipients: []string{"31612345678"}, Body: "Hi"})
+	assert.Equal(t, ErrSenderClosed, err)
+}
+
+func TestSenderMaxRetries(t *testing.T) {
+	requester := &throttledRequester{throttled: 10}
+	sender := NewSender(requester, &SenderOptions{MaxRetries: 1})
+
+	var sendErr error
+	err := sender.Send(context.Background(), &Job{
+		Originator: "TestName",
+		Recipients: []string{"31612345678"},
+		Body:       "Hello World",
+		Done:       func(_ *Message, err error) { sendErr = err },
+	})
+	assert.NoError(t, err)
+	sender.Close()
+
+	var rateLimitErr *messagebird.RateLimitError
+	assert.True(t, errors.As(sendErr, &rateLimitErr))
+	assert.Equal(t, 2, requester.requests)
+}
+
+func TestSenderInvalidJob(t *testing.T) {
+	sender := NewSender(&throttledRequester{}, nil)
+	defer sender.Close()
+
+	err := sender.Send(context.Background(), &Job{Originator: "TestName", Body: "Hello World"})
+	assert.Error(t, err)
+
+	err = sender.Send(context.Background(), &Job{Originator: "TestName", Recipients: make([]string, MaxRecipientsPerRequest+1), Body: "Hello World"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+package sms
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// MaxParts is the maximum number of parts a message body is split into.
+const MaxParts = 9
+
+// maxRecipientLength is the maximum number of digits of an E.164 number.
+const maxRecipientLength = 15
+
+// Validate checks a message like Create does, without sending it, e.g. to
+// validate a form. On top of the checks of Create, it checks that all
+// recipients are phone numbers and that the body fits in MaxParts parts. It
+// returns how the body is split into parts.
+//
+// Binary messages are checked to have a hex encoded body and udh that fit in
+// MaxBinaryPartSize bytes. No PartCount is returned for them.
+//
+// Validate doesn't contact the API, so it can't check whether e.g. the
+// originator is allowed for the account. To validate a message with the API
+// without sending it, call Create with a client that uses a test access key,
+// see messagebird.IsTestAccessKey.
+func Validate(originator string, recipients []string, body string, params *Params) (*PartCount, error) {
+	request, err := requestDataForMessage(originator, recipients, body, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recipient := range recipients {
+		if err := validateRecipient(recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Type == TypeBinary {
+		return nil, validateBinary(request.Body, request.TypeDetails)
+	}
+
+	count := CountParts(request.Body, request.DataCoding)
+	if count.Parts > MaxParts {
+		return nil, fmt.Errorf("%w: body of %d characters needs %d parts, at most %d are allowed", messagebird.ErrInvalidParams, count.Length, count.Parts, MaxParts)
+	}
+
+	return &count, nil
+}
+
+// validateBinary returns an error if the hex encoded body and UDH of a binary
+// message are invalid or don't fit in a single message.
+func validateBinary(body string, typeDetails TypeDetails) error {
+	data, err := hex.DecodeString(body)
+	if err != nil {
+		return fmt.Errorf("%w: binary body must be hex encoded: %v", messagebird.ErrInvalidParams, err)
+	}
+
+	var udh []byte
+	if v, ok := typeDetails["udh"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%w: udh must be a hex encoded string", messagebird.ErrInvalidParams)
+		}
+		if udh, err = hex.DecodeString(s); err != nil {
+			return fmt.Errorf("%w: udh must be hex encoded: %v", messagebird.ErrInvalidParams, err)
+		}
+	}
+
+	if size := len(udh) + len(data); size > MaxBinaryPartSize {
+		return fmt.Errorf("%w: binary message of %d bytes exceeds the maximum of %d bytes", messagebird.ErrInvalidParams, size, MaxBinaryPartSize)
+	}
+	return nil
+}
+
+// validateRecipient returns an error if recipient is not a phone number in
+// international format, optionally with a leading +.
+func validateRecipient(recipient string) error {
+	recipient = strings.TrimPrefix(recipient, "+")
+	if recipient == "" {
+		return fmt.Errorf("%w: recipient is empty", messagebird.ErrInvalidParams)
+	}
+	for _, r := range recipient {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("%w: recipient %q must only contain digits", messagebird.ErrInvalidParams, recipient)
+		}
+	}
+	if recipient[0] == '0' {
+		return fmt.Errorf("%w: recipient %q must be in international format", messagebird.ErrInvalidParams, recipient)
+	}
+	if len(recipient) > maxRecipientLength {
+		return fmt.Errorf("%w: recipient %q must have at most %d digits", messagebird.ErrInvalidParams, recipient, maxRecipientLength)
+	}
+	return nil
+}
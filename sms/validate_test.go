@@ -0,0 +1,82 @@
+package sms
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	count, err := Validate("TestName", []string{"31612345678", "31612345679"}, "Hello World", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count.Parts)
+	assert.Equal(t, DataCodingPlain, count.DataCoding)
+
+	count, err = Validate("TestName", []string{"31612345678"}, "Grüße 👋", &Params{DataCoding: DataCodingAuto})
+	assert.NoError(t, err)
+	assert.Equal(t, DataCodingUnicode, count.DataCoding)
+
+	count, err = Validate("TestName", []string{"31612345678"}, "48656c6c6f", &Params{Type: TypeBinary})
+	assert.NoError(t, err)
+	assert.Nil(t, count)
+
+	count, err = Validate("TestName", []string{"31612345678"}, "48656c6c6f", &Params{Type: TypeBinary, TypeDetails: TypeDetails{"udh": "050003cc0201"}})
+	assert.NoError(t, err)
+	assert.Nil(t, count)
+}
+
+func TestValidateInvalidBinary(t *testing.T) {
+	tt := []struct {
+		name       string
+		recipients []string
+		body       string
+		udh        interface{}
+	}{
+		{"recipient with letters", []string{"not-a-number"}, "48656c6c6f", nil},
+		{"body not hex", []string{"31612345678"}, "Hello World", nil},
+		{"odd body", []string{"31612345678"}, "48656c6c6", nil},
+		{"udh not hex", []string{"31612345678"}, "48656c6c6f", "zz"},
+		{"udh not a string", []string{"31612345678"}, "48656c6c6f", 5},
+		{"too long", []string{"31612345678"}, strings.Repeat("aa", MaxBinaryPartSize-5), "050003cc0201"},
+	}
+
+	for _, tc := range tt {
+		params := &Params{Type: TypeBinary}
+		if tc.udh != nil {
+			params.TypeDetails = TypeDetails{"udh": tc.udh}
+		}
+		_, err := Validate("TestName", tc.recipients, tc.body, params)
+		assert.True(t, errors.Is(err, messagebird.ErrInvalidParams), "%s: %v", tc.name, err)
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	tt := []struct {
+		name       string
+		originator string
+		recipients []string
+		body       string
+	}{
+		{"originator", "Too long originator", []string{"31612345678"}, "Hello World"},
+		{"no recipients", "TestName", nil, "Hello World"},
+		{"recipient with letters", "TestName", []string{"31612345678", "not-a-number"}, "Hello World"},
+		{"national recipient", "TestName", []string{"0612345678"}, "Hello World"},
+		{"long recipient", "TestName", []string{"3161234567890123"}, "Hello World"},
+		{"empty body", "TestName", []string{"31612345678"}, ""},
+		{"long body", "TestName", []string{"31612345678"}, strings.Repeat("a", 153*MaxParts+1)},
+	}
+
+	for _, tc := range tt {
+		_, err := Validate(tc.originator, tc.recipients, tc.body, nil)
+		assert.Error(t, err, tc.name)
+	}
+
+	_, err := Validate("TestName", []string{"+31612345678"}, "Hello World", nil)
+	assert.NoError(t, err)
+
+	_, err = Validate("TestName", []string{"31 612345678"}, "Hello World", nil)
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+}
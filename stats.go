@@ -0,0 +1,40 @@
+package messagebird
+
+import "time"
+
+// RequestStats describes a completed API call.
+type RequestStats struct {
+	Method string
+	Host   string
+	Path   string // URL path, excluding the query.
+
+	// StatusCode is the HTTP status code of the last response. It's zero if no
+	// response was received.
+	StatusCode int
+
+	// Duration is the total time spent on the call, including the time spent
+	// waiting between retries.
+	Duration time.Duration
+
+	// Attempts is the number of HTTP requests made for the call.
+	Attempts int
+
+	// Err is the error returned to the caller, if any.
+	Err error
+}
+
+// StatsRecorder receives metrics for every API call made by a Client. It
+// must be safe for concurrent use. Implementations typically forward the stats
+// to expvar or Prometheus.
+type StatsRecorder interface {
+	RecordRequest(stats RequestStats)
+}
+
+// StatsRecorderFunc is an adapter to allow the use of ordinary functions as
+// StatsRecorders.
+type StatsRecorderFunc func(stats RequestStats)
+
+// RecordRequest calls f(stats).
+func (f StatsRecorderFunc) RecordRequest(stats RequestStats) {
+	f(stats)
+}
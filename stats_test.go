@@ -0,0 +1,32 @@
+package messagebird
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":20,"description":"message not found","parameter":null}]}`))
+	})
+
+	var recorded []RequestStats
+	client.Stats = StatsRecorderFunc(func(stats RequestStats) {
+		recorded = append(recorded, stats)
+	})
+
+	err := client.Request(nil, http.MethodGet, srv.URL+"/messages/some-id?foo=bar", nil)
+	assert.Error(t, err)
+
+	assert.Len(t, recorded, 1)
+	assert.Equal(t, http.MethodGet, recorded[0].Method)
+	assert.Equal(t, "/messages/some-id", recorded[0].Path)
+	assert.Equal(t, http.StatusNotFound, recorded[0].StatusCode)
+	assert.Equal(t, 1, recorded[0].Attempts)
+	assert.True(t, recorded[0].Duration > 0)
+	assert.True(t, errors.Is(recorded[0].Err, ErrNotFound))
+}
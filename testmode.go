@@ -0,0 +1,97 @@
+package messagebird
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// testAccessKeyPrefix is the prefix of access keys for the API's test mode.
+const testAccessKeyPrefix = "test_"
+
+// IsTestAccessKey reports whether key is a test access key. Requests made with
+// test keys are validated, but not executed: the API returns stubbed objects
+// and nothing is sent or charged.
+func IsTestAccessKey(key string) bool {
+	return strings.HasPrefix(key, testAccessKeyPrefix)
+}
+
+// testModeIDs holds the IDs of the objects a client created in test mode. A
+// nil *testModeIDs holds no IDs.
+type testModeIDs struct {
+	mu  sync.Mutex
+	ids map[string]bool
+}
+
+// add records the IDs of the objects in body, the response to a create. Both
+// plain objects and the data arrays of e.g. the Voice API are supported.
+func (s *testModeIDs) add(body []byte) {
+	if s == nil {
+		return
+	}
+
+	var created struct {
+		ID   string
+		Data []struct{ ID string }
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ids == nil {
+		s.ids = make(map[string]bool)
+	}
+	if created.ID != "" {
+		s.ids[created.ID] = true
+	}
+	for _, object := range created.Data {
+		if object.ID != "" {
+			s.ids[object.ID] = true
+		}
+	}
+}
+
+// has reports whether id was recorded by add.
+func (s *testModeIDs) has(id string) bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ids[id]
+}
+
+// isTestModeNotFound reports whether response is a 404 that should be
+// relaxed because the client is in test mode, and uri names an object the
+// client created.
+func (c *Client) isTestModeNotFound(method string, uri *url.URL, response *http.Response) bool {
+	if !c.TestMode || response == nil || response.StatusCode != http.StatusNotFound {
+		return false
+	}
+	if method != http.MethodGet && method != http.MethodDelete {
+		return false
+	}
+	return c.testModeIDs.has(path.Base(uri.Path))
+}
+
+// decodeTestModeObject decodes a stub of the resource at uri into v, for
+// reads of resources the API doesn't store in test mode. The stub only has its
+// ID and href set.
+func decodeTestModeObject(v interface{}, method string, uri *url.URL) error {
+	if v == nil || method == http.MethodDelete {
+		return nil
+	}
+
+	u := *uri
+	u.RawQuery = ""
+	return decodeDryRun(v, uri, map[string]interface{}{
+		"id":   path.Base(uri.Path),
+		"href": u.String(),
+	})
+}
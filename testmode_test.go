@@ -0,0 +1,72 @@
+package messagebird
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestAccessKey(t *testing.T) {
+	assert.True(t, IsTestAccessKey("test_gshuPaZoeEG6ovbc8M79w0QyM"))
+	assert.False(t, IsTestAccessKey("live_gshuPaZoeEG6ovbc8M79w0QyM"))
+}
+
+func TestTestMode(t *testing.T) {
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/messages" {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"some-id"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":20,"description":"message not found","parameter":null}]}`))
+	})
+
+	var message struct {
+		ID   string
+		HRef string
+	}
+	err := client.Request(&message, http.MethodGet, srv.URL+"/messages/some-id", nil)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	client.TestMode = true
+
+	// Objects that were not created by the client are still not found.
+	err = client.Request(&message, http.MethodGet, srv.URL+"/messages/some-id", nil)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	assert.NoError(t, client.Request(&message, http.MethodPost, srv.URL+"/messages", map[string]string{}))
+
+	var md ResponseMetadata
+	ctx := WithResponseMetadata(context.Background(), &md)
+	assert.NoError(t, client.RequestContext(ctx, &message, http.MethodGet, srv.URL+"/messages/some-id?token=123", nil))
+	assert.Equal(t, "some-id", message.ID)
+	assert.Equal(t, srv.URL+"/messages/some-id", message.HRef)
+	assert.True(t, md.TestMode)
+
+	assert.NoError(t, client.WithContext(context.Background()).Request(nil, http.MethodDelete, srv.URL+"/messages/some-id", nil))
+
+	err = client.Request(&message, http.MethodGet, srv.URL+"/messages/other-id", nil)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	// Other creates still fail.
+	err = client.Request(&message, http.MethodPost, srv.URL+"/groups", map[string]string{})
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestTestModeIDs(t *testing.T) {
+	var nilIDs *testModeIDs
+	nilIDs.add([]byte(`{"id":"id"}`))
+	assert.False(t, nilIDs.has("id"))
+
+	ids := &testModeIDs{}
+	ids.add([]byte(`{"id":"message-id"}`))
+	ids.add([]byte(`{"data":[{"id":"call-id"}]}`))
+	ids.add([]byte(`not json`))
+	assert.True(t, ids.has("message-id"))
+	assert.True(t, ids.has("call-id"))
+	assert.False(t, ids.has(""))
+}
@@ -0,0 +1,42 @@
+package messagebird
+
+import (
+	"runtime"
+	"strings"
+)
+
+// AppInfo identifies the application using the client. It is appended to the
+// User-Agent header, so MessageBird support can identify its traffic.
+type AppInfo struct {
+	Name    string // Required, e.g. "MyApp".
+	Version string // Optional, e.g. "1.2.0".
+	URL     string // Optional, e.g. "https://example.com".
+}
+
+// String formats info for use in a User-Agent header, e.g.
+// "MyApp/1.2.0 (+https://example.com)". It returns an empty string if info has
+// no Name.
+func (info AppInfo) String() string {
+	if info.Name == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(info.Name)
+	if info.Version != "" {
+		b.WriteString("/" + info.Version)
+	}
+	if info.URL != "" {
+		b.WriteString(" (+" + info.URL + ")")
+	}
+	return b.String()
+}
+
+// userAgent returns the value of the User-Agent header of requests.
+func (c *Client) userAgent() string {
+	ua := "MessageBird/ApiClient/" + ClientVersion + " Go/" + runtime.Version()
+	if app := c.AppInfo.String(); app != "" {
+		ua += " " + app
+	}
+	return ua
+}
@@ -0,0 +1,32 @@
+package messagebird
+
+import (
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppInfo(t *testing.T) {
+	base := "MessageBird/ApiClient/" + ClientVersion + " Go/" + runtime.Version()
+
+	var userAgent string
+	client, srv := testServer(t, func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, base, userAgent)
+
+	client.AppInfo = AppInfo{Name: "MyApp", Version: "1.2.0", URL: "https://example.com"}
+	assert.NoError(t, client.Request(nil, http.MethodGet, srv.URL+"/balance", nil))
+	assert.Equal(t, base+" MyApp/1.2.0 (+https://example.com)", userAgent)
+}
+
+func TestAppInfoString(t *testing.T) {
+	assert.Equal(t, "", AppInfo{Version: "1.2.0"}.String())
+	assert.Equal(t, "MyApp", AppInfo{Name: "MyApp"}.String())
+	assert.Equal(t, "MyApp (+https://example.com)", AppInfo{Name: "MyApp", URL: "https://example.com"}.String())
+}
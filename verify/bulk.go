@@ -0,0 +1,38 @@
+package verify
+
+import (
+	"context"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// BulkResult is the outcome of creating a verification for one of the
+// recipients passed to CreateBulk.
+type BulkResult struct {
+	Recipient string
+	Verify    *Verify // Verify is nil if creating the verification failed.
+	Err       error
+}
+
+// CreateBulk creates a verification with params for every recipient, making up
+// to concurrency requests at the same time (messagebird.DefaultConcurrency if
+// not positive). It returns a result per recipient, in the order of
+// recipients. When ctx is done, no more verifications are created and the
+// remaining recipients fail with ctx's error.
+func CreateBulk(ctx context.Context, c messagebird.Requester, recipients []string, params *Params, concurrency int) []BulkResult {
+	verifies, err := messagebird.Parallel(ctx, recipients, concurrency, func(ctx context.Context, recipient string) (*Verify, error) {
+		return CreateContext(ctx, c, recipient, params)
+	})
+
+	results := make([]BulkResult, len(recipients))
+	for i, recipient := range recipients {
+		results[i] = BulkResult{Recipient: recipient, Verify: verifies[i]}
+	}
+	if parallelErr, ok := err.(*messagebird.ParallelError); ok {
+		for i, err := range parallelErr.Errors {
+			results[i].Err = err
+		}
+	}
+
+	return results
+}
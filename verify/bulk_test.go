@@ -0,0 +1,60 @@
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recipientRequester creates Verify objects for every recipient except
+// failRecipient.
+type recipientRequester struct {
+	mu            sync.Mutex
+	failRecipient string
+	requests      int
+}
+
+func (r *recipientRequester) Request(v interface{}, method, path string, data interface{}) error {
+	r.mu.Lock()
+	r.requests++
+	r.mu.Unlock()
+
+	recipient := data.(*verifyRequest).Recipient
+	if recipient == r.failRecipient {
+		return errors.New("request failed")
+	}
+	return json.Unmarshal([]byte(`{"id":"id-`+recipient+`","recipient":"`+recipient+`","status":"sent"}`), v)
+}
+
+func TestCreateBulk(t *testing.T) {
+	requester := &recipientRequester{failRecipient: "31612345679"}
+	recipients := []string{"31612345678", "31612345679", "", "31612345680"}
+
+	results := CreateBulk(context.Background(), requester, recipients, &Params{Reference: "onboarding"}, 2)
+	assert.Len(t, results, 4)
+	assert.Equal(t, 3, requester.requests)
+
+	assert.Equal(t, "31612345678", results[0].Recipient)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "id-31612345678", results[0].Verify.ID)
+
+	assert.EqualError(t, results[1].Err, "request failed")
+	assert.Nil(t, results[1].Verify)
+
+	assert.EqualError(t, results[2].Err, "invalid or missing parameters: recipient is required")
+
+	assert.NoError(t, results[3].Err)
+	assert.Equal(t, "31612345680", results[3].Verify.Recipient)
+}
+
+func TestCreateBulkCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := CreateBulk(ctx, &recipientRequester{}, []string{"31612345678"}, nil, 0)
+	assert.Equal(t, context.Canceled, results[0].Err)
+}
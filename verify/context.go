@@ -0,0 +1,123 @@
+package verify
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// requesterContext returns the context requests made with c without an
+// explicit context are bound to: the client's context for a
+// *messagebird.Client.
+func requesterContext(c messagebird.Requester) context.Context {
+	if cc, ok := c.(interface{ Context() context.Context }); ok {
+		return cc.Context()
+	}
+	return context.Background()
+}
+
+// requestVerify makes a request returning a Verify object, and sets its Limits
+// from the response headers. The response metadata is also stored in the
+// ResponseMetadata registered with ctx, if any.
+func requestVerify(ctx context.Context, c messagebird.Requester, method, path string, data interface{}) (*Verify, error) {
+	md := &messagebird.ResponseMetadata{}
+	callerMD := messagebird.ResponseMetadataFromContext(ctx)
+
+	verify := &Verify{}
+	err := messagebird.RequestContext(messagebird.WithResponseMetadata(ctx, md), c, verify, method, path, data)
+	if callerMD != nil && md.StatusCode != 0 {
+		*callerMD = *md
+	}
+	if err != nil {
+		return nil, err
+	}
+	verify.Limits = limitsFromMetadata(md)
+
+	return verify, nil
+}
+
+// CreateContext is like Create, but the request is bound to ctx.
+func CreateContext(ctx context.Context, c messagebird.Requester, recipient string, params *Params) (*Verify, error) {
+	requestData, err := requestDataForVerify(recipient, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return requestVerify(ctx, c, http.MethodPost, path, requestData)
+}
+
+// ResendContext is like Resend, but the request is bound to ctx.
+func ResendContext(ctx context.Context, c messagebird.Requester, id string) (*Verify, error) {
+	return requestVerify(ctx, c, http.MethodPost, path+"/"+id+"/resend", nil)
+}
+
+// ListContext is like List, but the request is bound to ctx.
+func ListContext(ctx context.Context, c messagebird.Requester, params *ListParams) (*VerifyList, error) {
+	verifyList := &VerifyList{}
+	if err := messagebird.RequestContext(ctx, c, verifyList, http.MethodGet, path+"?"+paramsForList(params).Encode(), nil); err != nil {
+		return nil, err
+	}
+
+	return verifyList, nil
+}
+
+// DeleteContext is like Delete, but the request is bound to ctx.
+func DeleteContext(ctx context.Context, c messagebird.Requester, id string) error {
+	return messagebird.RequestContext(ctx, c, nil, http.MethodDelete, path+"/"+id, nil)
+}
+
+// ReadContext is like Read, but the request is bound to ctx.
+func ReadContext(ctx context.Context, c messagebird.Requester, id string) (*Verify, error) {
+	return requestVerify(ctx, c, http.MethodGet, path+"/"+id, nil)
+}
+
+// VerifyTokenContext is like VerifyToken, but the request is bound to ctx.
+func VerifyTokenContext(ctx context.Context, c messagebird.Requester, id, token string) (*Verify, error) {
+	params := &url.Values{}
+	params.Set("token", token)
+
+	pathWithParams := path + "/" + id + "?" + params.Encode()
+
+	verify, err := requestVerify(ctx, c, http.MethodGet, pathWithParams, nil)
+	if err != nil {
+		return nil, tokenCheckError(ctx, c, id, err)
+	}
+
+	return verify, nil
+}
+
+// CheckTokenContext is like CheckToken, but the request is bound to ctx.
+func CheckTokenContext(ctx context.Context, c messagebird.Requester, id, token string) (*Verify, error) {
+	requestData := &checkTokenRequest{Token: token}
+
+	verify, err := requestVerify(ctx, c, http.MethodPost, path+"/"+id, requestData)
+	if err != nil {
+		return nil, tokenCheckError(ctx, c, id, err)
+	}
+
+	return verify, nil
+}
+
+// ReadVerifyEmailMessageContext is like ReadVerifyEmailMessage, but the
+// request is bound to ctx.
+func ReadVerifyEmailMessageContext(ctx context.Context, c messagebird.Requester, id string) (*VerifyMessage, error) {
+	verifyMessage := &VerifyMessage{}
+	if err := messagebird.RequestContext(ctx, c, verifyMessage, http.MethodGet, emailMessagesPath+"/"+id, nil); err != nil {
+		return nil, err
+	}
+
+	return verifyMessage, nil
+}
+
+// ReadVerifyVoiceMessageContext is like ReadVerifyVoiceMessage, but the
+// request is bound to ctx.
+func ReadVerifyVoiceMessageContext(ctx context.Context, c messagebird.Requester, id string) (*VerifyMessage, error) {
+	verifyMessage := &VerifyMessage{}
+	if err := messagebird.RequestContext(ctx, c, verifyMessage, http.MethodGet, voiceMessagesPath+"/"+id, nil); err != nil {
+		return nil, err
+	}
+
+	return verifyMessage, nil
+}
@@ -0,0 +1,90 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadContext(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := ReadContext(context.Background(), client, "15498233759288aaf929661v21936686")
+	assert.NoError(t, err)
+	assertVerifyObject(t, v)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/verify/15498233759288aaf929661v21936686")
+}
+
+func TestContextCanceled(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := CreateContext(ctx, client, "31612345678", nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = VerifyTokenContext(ctx, client, "15498233759288aaf929661v21936686", "123456")
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	// The non-context functions use the client's context.
+	_, err = Read(client.WithContext(ctx), "15498233759288aaf929661v21936686")
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = Read(client, "15498233759288aaf929661v21936686")
+	assert.NoError(t, err)
+}
+
+func TestLimits(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(AttemptsRemainingHeader, "2")
+		w.Header().Set("Retry-After", "30")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		_, err := w.Write(mbtest.Testdata(t, "verifyObject.json"))
+		assert.NoError(t, err)
+	})
+	transport, teardown := mbtest.HTTPTestTransport(h)
+	defer teardown()
+
+	client := mbtest.Client(t)
+	client.HTTPClient.Transport = transport
+
+	var md messagebird.ResponseMetadata
+	ctx := messagebird.WithResponseMetadata(context.Background(), &md)
+
+	v, err := CheckTokenContext(ctx, client, "15498233759288aaf929661v21936686", "123456")
+	assert.NoError(t, err)
+	if assert.NotNil(t, v.Limits) && assert.NotNil(t, v.Limits.AttemptsRemaining) {
+		assert.Equal(t, 2, *v.Limits.AttemptsRemaining)
+		assert.Equal(t, 30*time.Second, v.Limits.RetryAfter)
+		assert.Equal(t, 99, v.Limits.RateLimit.Remaining)
+	}
+
+	// The caller's metadata is still stored.
+	assert.Equal(t, http.StatusOK, md.StatusCode)
+}
+
+func TestLimitsUnknown(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := Read(client, "15498233759288aaf929661v21936686")
+	assert.NoError(t, err)
+	if assert.NotNil(t, v.Limits) {
+		assert.Nil(t, v.Limits.AttemptsRemaining)
+		assert.Zero(t, v.Limits.RetryAfter)
+	}
+
+	v, err = WaitForCompletion(context.Background(), &statusRequester{statuses: []string{StatusVerified}}, "id", time.Millisecond)
+	assert.NoError(t, err)
+	assert.Nil(t, v.Limits)
+}
@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"context"
+	"errors"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/sentinel"
+)
+
+// Errors returned by VerifyToken and CheckToken (and their context variants)
+// when the token was not accepted. Match them with errors.Is. The original
+// messagebird.ErrorResponse is still available through errors.As.
+var (
+	// ErrInvalidToken is returned when the token does not match.
+	ErrInvalidToken = errors.New("verify: invalid token")
+
+	// ErrTokenExpired is returned when the token expired before it was
+	// checked.
+	ErrTokenExpired = errors.New("verify: token expired")
+
+	// ErrAlreadyProcessed is returned when the verification was already
+	// verified or failed, so the token can't be checked anymore.
+	ErrAlreadyProcessed = errors.New("verify: verification already processed")
+)
+
+// tokenCheckError maps err, as returned when checking a token of the Verify
+// object with the given ID, to one of the token sentinel errors. The API
+// rejects all tokens with ErrorCodeInvalidParams for the token parameter, so
+// the Verify object is read to tell whether it expired or was already
+// processed. If that read fails without an API error, e.g. because ctx is done
+// or the request could not be sent, its error is returned instead. Other
+// errors are returned as is.
+func tokenCheckError(ctx context.Context, c messagebird.Requester, id string, err error) error {
+	var mbErr messagebird.Error
+	if !errors.As(err, &mbErr) || mbErr.Code != messagebird.ErrorCodeInvalidParams || mbErr.Parameter != "token" {
+		return err
+	}
+
+	verify, readErr := ReadContext(ctx, c, id)
+	if readErr != nil {
+		var errResp messagebird.ErrorResponse
+		if !errors.As(readErr, &errResp) {
+			return readErr
+		}
+		return sentinel.Wrap(ErrInvalidToken, err)
+	}
+	switch verify.Status {
+	case StatusExpired:
+		return sentinel.Wrap(ErrTokenExpired, err)
+	case StatusVerified, StatusFailed:
+		return sentinel.Wrap(ErrAlreadyProcessed, err)
+	}
+	return sentinel.Wrap(ErrInvalidToken, err)
+}
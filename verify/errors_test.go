@@ -0,0 +1,96 @@
+package verify
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// tokenRequester rejects token checks with checkErr, and returns a Verify
+// object with status when it's read, or readErr if set.
+type tokenRequester struct {
+	checkErr error
+	readErr  error
+	status   string
+}
+
+func (r *tokenRequester) Request(v interface{}, method, path string, data interface{}) error {
+	if method == http.MethodPost || strings.Contains(path, "token=") {
+		return r.checkErr
+	}
+	if r.readErr != nil {
+		return r.readErr
+	}
+	return json.Unmarshal([]byte(`{"id":"15498233759288aaf929661v21936686","status":"`+r.status+`"}`), v)
+}
+
+func TestTokenCheckErrors(t *testing.T) {
+	invalidToken := messagebird.ErrorResponse{
+		Errors:     []messagebird.Error{{Code: messagebird.ErrorCodeInvalidParams, Description: "The token is invalid.", Parameter: "token"}},
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+	invalidID := messagebird.ErrorResponse{
+		Errors:     []messagebird.Error{{Code: messagebird.ErrorCodeInvalidParams, Description: "The id is invalid.", Parameter: "id"}},
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+
+	tt := []struct {
+		name     string
+		checkErr error
+		status   string
+		want     error
+	}{
+		{"invalid", invalidToken, StatusSent, ErrInvalidToken},
+		{"expired", invalidToken, StatusExpired, ErrTokenExpired},
+		{"verified", invalidToken, StatusVerified, ErrAlreadyProcessed},
+		{"failed", invalidToken, StatusFailed, ErrAlreadyProcessed},
+		{"other", invalidID, StatusSent, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			requester := &tokenRequester{checkErr: tc.checkErr, status: tc.status}
+
+			for _, check := range []func(messagebird.Requester, string, string) (*Verify, error){CheckToken, VerifyToken} {
+				_, err := check(requester, "15498233759288aaf929661v21936686", "123456")
+				assert.Error(t, err)
+				for _, sentinel := range []error{ErrInvalidToken, ErrTokenExpired, ErrAlreadyProcessed} {
+					assert.Equal(t, sentinel == tc.want, errors.Is(err, sentinel), sentinel.Error())
+				}
+
+				// The API error is still available.
+				assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+				var errorResponse messagebird.ErrorResponse
+				assert.True(t, errors.As(err, &errorResponse))
+			}
+		})
+	}
+}
+
+func TestTokenCheckReadError(t *testing.T) {
+	invalidToken := messagebird.ErrorResponse{
+		Errors:     []messagebird.Error{{Code: messagebird.ErrorCodeInvalidParams, Description: "The token is invalid.", Parameter: "token"}},
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+	readErr := errors.New("connection reset")
+	requester := &tokenRequester{checkErr: invalidToken, readErr: readErr}
+
+	_, err := CheckToken(requester, "15498233759288aaf929661v21936686", "123456")
+	assert.Equal(t, readErr, err)
+}
+
+func TestVerifyTokenInvalid(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"errors":[{"code":10,"description":"The token is invalid.","parameter":"token"}]}`), http.StatusUnprocessableEntity)
+	client := mbtest.Client(t)
+
+	// Reading the Verify object fails as well, which still results in
+	// ErrInvalidToken.
+	_, err := VerifyToken(client, "15498233759288aaf929661v21936686", "000000")
+	assert.True(t, errors.Is(err, ErrInvalidToken))
+}
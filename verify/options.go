@@ -0,0 +1,153 @@
+package verify
+
+import (
+	"fmt"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// Option configures a verification created with CreateWithOptions.
+type Option func(*Params)
+
+// WithType sets the channel the token is sent through, e.g. TypeTTS. The
+// default is TypeSMS.
+func WithType(verifyType string) Option {
+	return func(p *Params) { p.Type = verifyType }
+}
+
+// WithOriginator sets the sender of the token: a phone number or
+// alphanumeric sender ID, or an email address for TypeEmail.
+func WithOriginator(originator string) Option {
+	return func(p *Params) { p.Originator = originator }
+}
+
+// WithReference sets a client reference.
+func WithReference(reference string) Option {
+	return func(p *Params) { p.Reference = reference }
+}
+
+// WithTemplate sets the message the token is sent in. It must contain the
+// %token placeholder.
+func WithTemplate(template string) Option {
+	return func(p *Params) { p.Template = template }
+}
+
+// WithDataCoding sets the encoding of SMS messages, e.g. "unicode".
+func WithDataCoding(dataCoding string) Option {
+	return func(p *Params) { p.DataCoding = dataCoding }
+}
+
+// WithReportURL sets the URL status reports of the verification are sent to.
+func WithReportURL(reportURL string) Option {
+	return func(p *Params) { p.ReportURL = reportURL }
+}
+
+// WithVoice sets the voice the token is read out with, "male" or "female".
+func WithVoice(voice string) Option {
+	return func(p *Params) { p.Voice = voice }
+}
+
+// WithLanguage sets the language of the voice message or WhatsApp template.
+func WithLanguage(language string) Option {
+	return func(p *Params) { p.Language = language }
+}
+
+// WithTimeout sets how long the token is valid. It is rounded down to whole
+// seconds; timeouts that round down to zero are rejected rather than falling
+// back to the default.
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Params) {
+		p.Timeout = int(timeout / time.Second)
+		p.timeoutDuration = timeout
+	}
+}
+
+// WithTokenLength sets the number of digits of the token.
+func WithTokenLength(tokenLength int) Option {
+	return func(p *Params) { p.TokenLength = tokenLength }
+}
+
+// WithSubject sets the subject of the email for TypeEmail.
+func WithSubject(subject string) Option {
+	return func(p *Params) { p.Subject = subject }
+}
+
+// WithTemplateType sets the content type of the template of an email,
+// TemplateTypeText or TemplateTypeHTML.
+func WithTemplateType(templateType string) Option {
+	return func(p *Params) { p.TemplateType = templateType }
+}
+
+// WithWhatsAppTemplate sends the token over WhatsApp, using the approved
+// template templateName on the channel channelID. It implies
+// WithType(TypeWhatsApp).
+func WithWhatsAppTemplate(channelID, templateName string) Option {
+	return func(p *Params) {
+		p.Type = TypeWhatsApp
+		p.ChannelID = channelID
+		p.TemplateName = templateName
+	}
+}
+
+// WithRedirectURL sets the URL the device is redirected to after a silent
+// verification.
+func WithRedirectURL(redirectURL string) Option {
+	return func(p *Params) { p.RedirectURL = redirectURL }
+}
+
+// CreateWithOptions is like Create, but configures the verification with
+// options. Unlike Create, it returns an error for options that don't apply to
+// the verification's type, e.g. WithVoice for an SMS verification.
+func CreateWithOptions(c messagebird.Requester, recipient string, opts ...Option) (*Verify, error) {
+	params := &Params{}
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	if err := validateChannelParams(params); err != nil {
+		return nil, err
+	}
+
+	return Create(c, recipient, params)
+}
+
+// channelParams lists, per param, the types of verification it applies to.
+var channelParams = []struct {
+	name  string
+	set   func(p *Params) bool
+	types []string
+}{
+	{"template", func(p *Params) bool { return p.Template != "" }, []string{TypeSMS, TypeTTS, TypeEmail}},
+	{"dataCoding", func(p *Params) bool { return p.DataCoding != "" }, []string{TypeSMS}},
+	{"voice", func(p *Params) bool { return p.Voice != "" }, []string{TypeTTS}},
+	{"language", func(p *Params) bool { return p.Language != "" }, []string{TypeTTS, TypeWhatsApp}},
+	{"subject", func(p *Params) bool { return p.Subject != "" }, []string{TypeEmail}},
+	{"templateType", func(p *Params) bool { return p.TemplateType != "" }, []string{TypeEmail}},
+	{"channelId", func(p *Params) bool { return p.ChannelID != "" }, []string{TypeWhatsApp}},
+	{"templateName", func(p *Params) bool { return p.TemplateName != "" }, []string{TypeWhatsApp}},
+	{"redirectUrl", func(p *Params) bool { return p.RedirectURL != "" }, []string{TypeSilent}},
+}
+
+// validateChannelParams returns an error if params sets a param that doesn't
+// apply to its type.
+func validateChannelParams(params *Params) error {
+	verifyType := typeOrDefault(params.Type)
+
+	for _, param := range channelParams {
+		if param.set(params) && !contains(param.types, verifyType) {
+			return fmt.Errorf("%s is not supported for %s verifications", param.name, verifyType)
+		}
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
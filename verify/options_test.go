@@ -0,0 +1,77 @@
+package verify
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWithOptions(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := CreateWithOptions(client, "31612345678",
+		WithType(TypeTTS),
+		WithReference("MyReference"),
+		WithVoice("female"),
+		WithLanguage("nl-nl"),
+		WithTimeout(90*time.Second),
+		WithTokenLength(8),
+	)
+	assert.NoError(t, err)
+	assertVerifyObject(t, v)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/verify")
+	assert.JSONEq(t, `{
+		"recipient": "31612345678",
+		"reference": "MyReference",
+		"type": "tts",
+		"voice": "female",
+		"language": "nl-nl",
+		"timeout": 90,
+		"tokenLength": 8
+	}`, string(mbtest.Request.Body))
+}
+
+func TestCreateWithOptionsSubSecondTimeout(t *testing.T) {
+	client := mbtest.Client(t)
+
+	_, err := CreateWithOptions(client, "31612345678", WithTimeout(500*time.Millisecond))
+	assert.EqualError(t, err, "invalid or missing parameters: timeout of 500ms must be between 10 and 172800 seconds")
+	assert.ErrorIs(t, err, messagebird.ErrInvalidParams)
+}
+
+func TestValidateChannelParams(t *testing.T) {
+	tt := []struct {
+		name    string
+		opts    []Option
+		wantErr string
+	}{
+		{"sms defaults", []Option{WithTemplate("Code: %token"), WithDataCoding("unicode")}, ""},
+		{"voice on sms", []Option{WithVoice("male")}, "voice is not supported for sms verifications"},
+		{"subject on tts", []Option{WithType(TypeTTS), WithSubject("Code")}, "subject is not supported for tts verifications"},
+		{"whatsapp", []Option{WithWhatsAppTemplate("chid", "code"), WithLanguage("en")}, ""},
+		{"template on whatsapp", []Option{WithWhatsAppTemplate("chid", "code"), WithTemplate("%token")}, "template is not supported for whatsapp verifications"},
+		{"redirect on email", []Option{WithType(TypeEmail), WithRedirectURL("https://example.com")}, "redirectUrl is not supported for email verifications"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			params := &Params{}
+			for _, opt := range tc.opts {
+				opt(params)
+			}
+
+			err := validateChannelParams(params)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
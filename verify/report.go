@@ -0,0 +1,64 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/webhook"
+	"github.com/messagebird/go-rest-api/v7/signature"
+)
+
+// Report is a status report of a Verify object, as sent to the ReportURL of
+// the verification whenever its status changes.
+type Report struct {
+	ID             string
+	Reference      string
+	Recipient      string
+	Status         string
+	StatusDatetime time.Time
+}
+
+// ParseReport parses and validates the status report in r. Reports are sent
+// as query parameters, but form-encoded POST bodies are accepted too. Use
+// signature.Validator to check that r was sent by MessageBird.
+func ParseReport(r *http.Request) (*Report, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("invalid report: %w", err)
+	}
+
+	report := &Report{
+		ID:        r.Form.Get("id"),
+		Reference: r.Form.Get("reference"),
+		Recipient: r.Form.Get("recipient"),
+		Status:    r.Form.Get("status"),
+	}
+
+	if report.ID == "" {
+		return nil, errors.New("invalid report: id is missing")
+	}
+	switch report.Status {
+	case StatusSent, StatusVerified, StatusFailed, StatusExpired, StatusDeleted:
+	default:
+		return nil, fmt.Errorf("invalid report: unknown status %q", report.Status)
+	}
+
+	if statusDatetime := r.Form.Get("statusDatetime"); statusDatetime != "" {
+		t, err := time.Parse(time.RFC3339, statusDatetime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid report: statusDatetime: %w", err)
+		}
+		report.StatusDatetime = t
+	}
+
+	return report, nil
+}
+
+// ReportHandler returns a handler that parses status reports and passes them
+// to fn. If validator is not nil, requests with an invalid signature are
+// rejected with 401 Unauthorized. Invalid reports are rejected with 400 Bad
+// Request without calling fn.
+func ReportHandler(validator *signature.Validator, fn func(*Report)) http.Handler {
+	return webhook.Handler(validator, ParseReport, fn)
+}
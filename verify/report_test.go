@@ -0,0 +1,97 @@
+package verify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReport(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/reports?id=15498233759288aaf929661v21936686&reference=MyReference&recipient=31612345678&status=verified&statusDatetime=2017-05-26T20%3A06%3A17%2B00%3A00", nil)
+
+	report, err := ParseReport(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "15498233759288aaf929661v21936686", report.ID)
+	assert.Equal(t, "MyReference", report.Reference)
+	assert.Equal(t, "31612345678", report.Recipient)
+	assert.Equal(t, StatusVerified, report.Status)
+	assert.Equal(t, "2017-05-26T20:06:17Z", report.StatusDatetime.UTC().Format(time.RFC3339))
+}
+
+func TestParseReportForm(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/reports", strings.NewReader("id=id&status=expired"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	report, err := ParseReport(r)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusExpired, report.Status)
+	assert.True(t, report.StatusDatetime.IsZero())
+}
+
+func TestParseReportInvalid(t *testing.T) {
+	for _, query := range []string{
+		"status=sent",
+		"id=id",
+		"id=id&status=unknown",
+		"id=id&status=sent&statusDatetime=yesterday",
+	} {
+		_, err := ParseReport(httptest.NewRequest(http.MethodGet, "/reports?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+// signedRequest returns a GET request with query, signed with key.
+func signedRequest(key, query string) *http.Request {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	bodyHash := sha256.Sum256(nil)
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(ts + "\n" + query + "\n" + string(bodyHash[:])))
+
+	r := httptest.NewRequest(http.MethodGet, "/reports?"+query, nil)
+	r.Header.Set("MessageBird-Request-Timestamp", ts)
+	r.Header.Set("MessageBird-Signature", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return r
+}
+
+func TestReportHandler(t *testing.T) {
+	var reports []*Report
+	handler := ReportHandler(signature.NewValidator("secret"), func(r *Report) { reports = append(reports, r) })
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest("secret", "id=id&status=sent"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest("other-secret", "id=id&status=sent"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports?id=id&status=sent", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, signedRequest("secret", "status=sent"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Len(t, reports, 1)
+	assert.Equal(t, "id", reports[0].ID)
+}
+
+func TestReportHandlerWithoutValidator(t *testing.T) {
+	var reports []*Report
+	handler := ReportHandler(nil, func(r *Report) { reports = append(reports, r) })
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/reports?id=id&status=sent", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, reports, 1)
+}
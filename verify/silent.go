@@ -0,0 +1,94 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// DefaultPollInterval is how often WaitForCompletion reads the Verify object
+// when no interval is given.
+const DefaultPollInterval = 2 * time.Second
+
+// Silent contains the details of a silent verification. Instead of sending a
+// token, the recipient's mobile network confirms the phone number when
+// CheckURL is opened from the recipient's device over its mobile data
+// connection.
+type Silent struct {
+	CheckURL string
+}
+
+// SilentResult is the outcome of a silent verification, as passed to the
+// RedirectURL.
+type SilentResult struct {
+	ID     string
+	Status string
+}
+
+// IsVerified reports whether the network confirmed the phone number.
+func (r *SilentResult) IsVerified() bool {
+	return r.Status == StatusVerified
+}
+
+// CreateSilent starts a silent verification of recipient. Open the returned
+// Verify's Silent.CheckURL from the recipient's device, then wait for the
+// redirect to params.RedirectURL or poll with WaitForCompletion.
+func CreateSilent(c messagebird.Requester, recipient string, params *Params) (*Verify, error) {
+	silentParams := &Params{}
+	if params != nil {
+		*silentParams = *params
+	}
+	silentParams.Type = TypeSilent
+
+	return Create(c, recipient, silentParams)
+}
+
+// ParseSilentRedirect parses the result of a silent verification from the URL
+// the recipient's device was redirected to, e.g. r.URL in the handler serving
+// the RedirectURL. Always confirm the result with Read before trusting it, as
+// the URL is controlled by the device.
+func ParseSilentRedirect(u *url.URL) (*SilentResult, error) {
+	query := u.Query()
+
+	result := &SilentResult{
+		ID:     query.Get("id"),
+		Status: query.Get("status"),
+	}
+	if result.ID == "" || result.Status == "" {
+		return nil, errors.New("redirect URL does not contain a silent verification result")
+	}
+
+	return result, nil
+}
+
+// WaitForCompletion reads the Verify object with the given ID every interval
+// until it reaches a final status, and returns it. It stops early with the
+// context's error when ctx is done. Interval defaults to DefaultPollInterval
+// if it is not positive.
+func WaitForCompletion(ctx context.Context, c messagebird.Requester, id string, interval time.Duration) (*Verify, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		verify, err := ReadContext(ctx, c, id)
+		if err != nil {
+			return nil, err
+		}
+		if verify.IsFinal() {
+			return verify, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
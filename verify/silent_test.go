@@ -0,0 +1,92 @@
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSilent(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifySilentObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := CreateSilent(client, "31612345678", &Params{RedirectURL: "https://example.com/verified"})
+	assert.NoError(t, err)
+	if assert.NotNil(t, v.Silent) {
+		assert.Equal(t, "https://verify.messagebird.com/silent/0f3c2d1e5b7a4c8d9e6f1a2b3c4d5e6f", v.Silent.CheckURL)
+	}
+	assert.Nil(t, v.Message)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/verify")
+	assert.JSONEq(t, `{
+		"recipient": "31612345678",
+		"type": "silent",
+		"redirectUrl": "https://example.com/verified"
+	}`, string(mbtest.Request.Body))
+
+	_, err = CreateSilent(client, "client@example.com", nil)
+	assert.Error(t, err)
+}
+
+func TestParseSilentRedirect(t *testing.T) {
+	u, _ := url.Parse("https://example.com/verified?id=0f3c2d1e5b7a4c8d9e6f1a2b3c4d5e6f&status=verified")
+
+	result, err := ParseSilentRedirect(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "0f3c2d1e5b7a4c8d9e6f1a2b3c4d5e6f", result.ID)
+	assert.True(t, result.IsVerified())
+
+	u, _ = url.Parse("https://example.com/verified")
+	_, err = ParseSilentRedirect(u)
+	assert.Error(t, err)
+}
+
+// statusRequester returns a Verify object with the next of its statuses on
+// every request.
+type statusRequester struct {
+	statuses []string
+	requests int
+}
+
+func (r *statusRequester) Request(v interface{}, method, path string, data interface{}) error {
+	status := r.statuses[r.requests]
+	r.requests++
+	return json.Unmarshal([]byte(`{"id":"id","status":"`+status+`"}`), v)
+}
+
+func TestWaitForCompletion(t *testing.T) {
+	requester := &statusRequester{statuses: []string{StatusSent, StatusSent, StatusVerified}}
+
+	v, err := WaitForCompletion(context.Background(), requester, "id", time.Millisecond)
+	assert.NoError(t, err)
+	assert.True(t, v.IsVerified())
+	assert.Equal(t, 3, requester.requests)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	requester = &statusRequester{statuses: []string{StatusSent}}
+
+	_, err = WaitForCompletion(ctx, requester, "id", time.Hour)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitForCompletionDefaultInterval(t *testing.T) {
+	requester := &statusRequester{statuses: []string{StatusVerified}}
+
+	v, err := WaitForCompletion(context.Background(), requester, "id", 0)
+	assert.NoError(t, err)
+	assert.True(t, v.IsVerified())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	requester = &statusRequester{statuses: []string{StatusSent}}
+
+	_, err = WaitForCompletion(ctx, requester, "id", -time.Second)
+	assert.Equal(t, context.Canceled, err)
+}
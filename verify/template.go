@@ -0,0 +1,45 @@
+package verify
+
+import (
+	"fmt"
+	"strings"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// TokenPlaceholder is replaced by the token in a Template.
+const TokenPlaceholder = "%token"
+
+// defaultTemplates holds the default template per language.
+var defaultTemplates = map[string]string{
+	"de": "Ihr Bestätigungscode lautet %token.",
+	"en": "Your verification code is %token.",
+	"es": "Tu código de verificación es %token.",
+	"fr": "Votre code de vérification est %token.",
+	"it": "Il tuo codice di verifica è %token.",
+	"nl": "Je verificatiecode is %token.",
+	"pt": "O seu código de verificação é %token.",
+}
+
+// DefaultTemplate returns a template for language, e.g. "nl" or "nl-nl" as
+// used for Params.Language. It falls back to English for unknown languages.
+func DefaultTemplate(language string) string {
+	language = strings.ToLower(language)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+
+	if template, ok := defaultTemplates[language]; ok {
+		return template
+	}
+	return defaultTemplates["en"]
+}
+
+// ValidateTemplate checks that template contains TokenPlaceholder. The
+// returned error matches messagebird.ErrInvalidParams.
+func ValidateTemplate(template string) error {
+	if !strings.Contains(template, TokenPlaceholder) {
+		return fmt.Errorf("%w: template %q must contain the %s placeholder", messagebird.ErrInvalidParams, template, TokenPlaceholder)
+	}
+	return nil
+}
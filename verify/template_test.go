@@ -0,0 +1,25 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTemplate(t *testing.T) {
+	assert.Equal(t, "Je verificatiecode is %token.", DefaultTemplate("nl-nl"))
+	assert.Equal(t, "Ihr Bestätigungscode lautet %token.", DefaultTemplate("DE"))
+	assert.Equal(t, "Your verification code is %token.", DefaultTemplate("en-gb"))
+	assert.Equal(t, "Your verification code is %token.", DefaultTemplate("xx"))
+	assert.Equal(t, "Your verification code is %token.", DefaultTemplate(""))
+
+	for language, template := range defaultTemplates {
+		assert.NoError(t, ValidateTemplate(template), language)
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, ValidateTemplate("Your code is %token"))
+	assert.EqualError(t, ValidateTemplate("Your code is %code"), `invalid or missing parameters: template "Your code is %code" must contain the %token placeholder`)
+	assert.EqualError(t, ValidateTemplate(""), `invalid or missing parameters: template "" must contain the %token placeholder`)
+}
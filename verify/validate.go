@@ -0,0 +1,132 @@
+package verify
+
+import (
+	"fmt"
+	"net/mail"
+	"strconv"
+	"unicode/utf8"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+)
+
+// Bounds of the token params, as accepted by the API.
+const (
+	MinTokenLength = 6
+	MaxTokenLength = 10
+
+	// MinTimeout and MaxTimeout bound Params.Timeout, in seconds.
+	MinTimeout = 10
+	MaxTimeout = 48 * 60 * 60
+
+	// MaxSubjectLength is the maximum number of characters of the Subject of
+	// an email.
+	MaxSubjectLength = 255
+)
+
+// Validate checks params before they are sent to the API, so invalid values
+// fail with a descriptive error rather than a generic one from the API. Zero
+// values are valid: the API uses its defaults for them. Create calls Validate,
+// and ValidateRecipient for the recipient. The returned error matches
+// messagebird.ErrInvalidParams.
+func (p *Params) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	// The token of a flash call is part of a caller ID, and silent
+	// verifications have no token at all, so their lengths differ.
+	if p.TokenLength != 0 && p.Type != TypeFlashCall && p.Type != TypeSilent {
+		if p.TokenLength < MinTokenLength || p.TokenLength > MaxTokenLength {
+			return fmt.Errorf("%w: tokenLength %d must be between %d and %d", messagebird.ErrInvalidParams, p.TokenLength, MinTokenLength, MaxTokenLength)
+		}
+	}
+
+	if p.Timeout == 0 && p.timeoutDuration != 0 {
+		return fmt.Errorf("%w: timeout of %s must be between %d and %d seconds", messagebird.ErrInvalidParams, p.timeoutDuration, MinTimeout, MaxTimeout)
+	}
+	if p.Timeout != 0 && (p.Timeout < MinTimeout || p.Timeout > MaxTimeout) {
+		return fmt.Errorf("%w: timeout of %d seconds must be between %d and %d seconds", messagebird.ErrInvalidParams, p.Timeout, MinTimeout, MaxTimeout)
+	}
+
+	if p.Template != "" {
+		if err := ValidateTemplate(p.Template); err != nil {
+			return err
+		}
+	}
+
+	if p.Type == TypeWhatsApp && (p.ChannelID == "" || p.TemplateName == "") {
+		return fmt.Errorf("%w: channelID and templateName are required for whatsapp verifications", messagebird.ErrInvalidParams)
+	}
+
+	if err := p.validateEmail(); err != nil {
+		return err
+	}
+
+	if p.Originator != "" {
+		if err := validateOriginator(p.Originator, p.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateRecipient checks that recipient is set, and that it is a phone
+// number for flash call and silent verifications. p may be nil. The returned
+// error matches messagebird.ErrInvalidParams.
+func (p *Params) ValidateRecipient(recipient string) error {
+	if recipient == "" {
+		return fmt.Errorf("%w: recipient is required", messagebird.ErrInvalidParams)
+	}
+
+	if p != nil && (p.Type == TypeFlashCall || p.Type == TypeSilent) {
+		if _, err := strconv.ParseUint(recipient, 10, 64); err != nil {
+			return fmt.Errorf("%w: recipient %q must be a phone number for %s verifications", messagebird.ErrInvalidParams, recipient, p.Type)
+		}
+	}
+
+	return nil
+}
+
+// validateEmail checks the params of email verifications, and that they are
+// not set for other types.
+func (p *Params) validateEmail() error {
+	if p.Type != TypeEmail {
+		if p.Subject != "" || p.TemplateType != "" {
+			return fmt.Errorf("%w: subject and templateType are only supported for email verifications, not %s", messagebird.ErrInvalidParams, typeOrDefault(p.Type))
+		}
+		return nil
+	}
+
+	if n := utf8.RuneCountInString(p.Subject); n > MaxSubjectLength {
+		return fmt.Errorf("%w: subject of %d characters must be at most %d characters", messagebird.ErrInvalidParams, n, MaxSubjectLength)
+	}
+	switch p.TemplateType {
+	case "", TemplateTypeText, TemplateTypeHTML:
+	default:
+		return fmt.Errorf("%w: templateType %q must be %q or %q", messagebird.ErrInvalidParams, p.TemplateType, TemplateTypeText, TemplateTypeHTML)
+	}
+
+	return nil
+}
+
+// typeOrDefault returns verifyType, or TypeSMS if it is empty.
+func typeOrDefault(verifyType string) string {
+	if verifyType == "" {
+		return TypeSMS
+	}
+	return verifyType
+}
+
+// validateOriginator checks that originator is an email address for email
+// verifications, or a phone number or alphanumeric sender ID otherwise.
+func validateOriginator(originator, verifyType string) error {
+	if verifyType == TypeEmail {
+		if _, err := mail.ParseAddress(originator); err != nil {
+			return fmt.Errorf("%w: originator %q must be an email address for email verifications", messagebird.ErrInvalidParams, originator)
+		}
+		return nil
+	}
+
+	return messagebird.ValidateOriginator(originator)
+}
@@ -0,0 +1,87 @@
+package verify
+
+import (
+	"strings"
+	"testing"
+
+	messagebird "github.com/messagebird/go-rest-api/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tt := []struct {
+		name    string
+		params  *Params
+		wantErr string
+	}{
+		{"nil", nil, ""},
+		{"defaults", &Params{}, ""},
+		{"valid", &Params{Originator: "MSGBIRD", TokenLength: 10, Timeout: 300}, ""},
+		{"numeric originator", &Params{Originator: "+31612345678"}, ""},
+		{"email originator", &Params{Type: TypeEmail, Originator: "noreply@example.com"}, ""},
+		{"flash call token", &Params{Type: TypeFlashCall, TokenLength: 4}, ""},
+		{"short token", &Params{TokenLength: 4}, "invalid or missing parameters: tokenLength 4 must be between 6 and 10"},
+		{"long token", &Params{TokenLength: 11}, "invalid or missing parameters: tokenLength 11 must be between 6 and 10"},
+		{"short timeout", &Params{Timeout: 5}, "invalid or missing parameters: timeout of 5 seconds must be between 10 and 172800 seconds"},
+		{"long timeout", &Params{Timeout: 172801}, "invalid or missing parameters: timeout of 172801 seconds must be between 10 and 172800 seconds"},
+		{"long originator", &Params{Originator: "MessageBirdBV"}, `invalid or missing parameters: alphanumeric originator "MessageBirdBV" must be at most 11 characters`},
+		{"invalid originator", &Params{Originator: "MSG-BIRD"}, `invalid or missing parameters: alphanumeric originator "MSG-BIRD" may only contain letters, digits and spaces`},
+		{"long numeric originator", &Params{Originator: "316123456781234567"}, `invalid or missing parameters: numeric originator "316123456781234567" must be at most 15 digits`},
+		{"email originator on sms", &Params{Originator: "noreply@example.com"}, `invalid or missing parameters: alphanumeric originator "noreply@example.com" must be at most 11 characters`},
+		{"template", &Params{Template: "Your code: %token"}, ""},
+		{"template without token", &Params{Template: "Your code: %code"}, `invalid or missing parameters: template "Your code: %code" must contain the %token placeholder`},
+		{"html email", &Params{Type: TypeEmail, Subject: "Your code", TemplateType: TemplateTypeHTML, Template: "<b>%token</b>"}, ""},
+		{"long subject", &Params{Type: TypeEmail, Subject: strings.Repeat("a", 256)}, "invalid or missing parameters: subject of 256 characters must be at most 255 characters"},
+		{"invalid template type", &Params{Type: TypeEmail, TemplateType: "markdown"}, `invalid or missing parameters: templateType "markdown" must be "text" or "html"`},
+		{"subject on sms", &Params{Subject: "Your code"}, "invalid or missing parameters: subject and templateType are only supported for email verifications, not sms"},
+		{"template type on tts", &Params{Type: TypeTTS, TemplateType: TemplateTypeText}, "invalid or missing parameters: subject and templateType are only supported for email verifications, not tts"},
+		{"whatsapp", &Params{Type: TypeWhatsApp, ChannelID: "channel-id", TemplateName: "verify"}, ""},
+		{"whatsapp without template", &Params{Type: TypeWhatsApp, ChannelID: "channel-id"}, "invalid or missing parameters: channelID and templateName are required for whatsapp verifications"},
+		{"sms originator on email", &Params{Type: TypeEmail, Originator: "MSGBIRD"}, `invalid or missing parameters: originator "MSGBIRD" must be an email address for email verifications`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.ErrorIs(t, err, messagebird.ErrInvalidParams)
+			}
+		})
+	}
+}
+
+func TestParamsValidateRecipient(t *testing.T) {
+	tt := []struct {
+		name      string
+		params    *Params
+		recipient string
+		wantErr   string
+	}{
+		{"nil params", nil, "31612345678", ""},
+		{"email", &Params{Type: TypeEmail}, "user@example.com", ""},
+		{"missing", nil, "", "invalid or missing parameters: recipient is required"},
+		{"flash call", &Params{Type: TypeFlashCall}, "31612345678", ""},
+		{"flash call email", &Params{Type: TypeFlashCall}, "user@example.com", `invalid or missing parameters: recipient "user@example.com" must be a phone number for flashcall verifications`},
+		{"silent with plus", &Params{Type: TypeSilent}, "+31612345678", `invalid or missing parameters: recipient "+31612345678" must be a phone number for silent verifications`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateRecipient(tc.recipient)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.ErrorIs(t, err, messagebird.ErrInvalidParams)
+			}
+		})
+	}
+}
+
+func TestRequestDataForVerifyValidates(t *testing.T) {
+	_, err := requestDataForVerify("31612345678", &Params{TokenLength: 20})
+	assert.Error(t, err)
+}
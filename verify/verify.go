@@ -4,14 +4,40 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"net/mail"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	messagebird "github.com/messagebird/go-rest-api/v7"
 )
 
+// Types of verification, i.e. the channel the token is sent through.
+const (
+	TypeSMS       = "sms"
+	TypeTTS       = "tts"
+	TypeEmail     = "email"
+	TypeWhatsApp  = "whatsapp"
+	TypeFlashCall = "flashcall"
+	TypeSilent    = "silent"
+)
+
+// Content types of the Template of email verifications.
+const (
+	TemplateTypeText = "text"
+	TemplateTypeHTML = "html"
+)
+
+// Statuses of a Verify object.
+const (
+	StatusSent     = "sent"     // The token was sent and not verified yet.
+	StatusVerified = "verified" // The token was verified.
+	StatusFailed   = "failed"   // Verifying failed, e.g. after too many attempts.
+	StatusExpired  = "expired"  // The token expired before it was verified.
+	StatusDeleted  = "deleted"  // The Verify object was deleted.
+)
+
 // Verify object represents MessageBird server response.
 type Verify struct {
 	ID                 string
@@ -22,6 +48,157 @@ type Verify struct {
 	CreatedDatetime    *time.Time
 	ValidUntilDatetime *time.Time
 	Recipient          string
+
+	// ChannelID is the ID of the channel the token was sent through, for
+	// WhatsApp verifications.
+	ChannelID string
+
+	// FlashCall describes the caller ID the recipient is called from, for
+	// flash-call verifications.
+	FlashCall *FlashCall
+
+	// Silent contains the URL to open from the recipient's device, for
+	// silent verifications.
+	Silent *Silent
+
+	// Message links to the message the token was sent in. It is set from
+	// Messages, and nil if the response didn't link to a message.
+	Message *MessageLink
+
+	// Limits holds the attempt and rate limit information of the response the
+	// Verify object was returned in. It is nil if the response's headers are
+	// unknown, e.g. for fake Requesters.
+	Limits *Limits
+}
+
+// AttemptsRemainingHeader is the response header with the number of token
+// checks left before a verification fails.
+const AttemptsRemainingHeader = "X-MessageBird-Verify-Attempts-Remaining"
+
+// Limits describes how many more requests can be made for a verification.
+type Limits struct {
+	// AttemptsRemaining is the number of token checks left before the
+	// verification fails. It is nil if the API didn't report it.
+	AttemptsRemaining *int
+
+	// RetryAfter is the time to wait before a new token can be requested,
+	// e.g. with Resend. It is zero if the API didn't report it.
+	RetryAfter time.Duration
+
+	// RateLimit is the rate limit state of the access key.
+	RateLimit messagebird.RateLimit
+}
+
+// limitsFromMetadata returns the Limits in the headers of md.
+func limitsFromMetadata(md *messagebird.ResponseMetadata) *Limits {
+	if md.StatusCode == 0 {
+		return nil
+	}
+
+	limits := &Limits{
+		RetryAfter: md.RetryAfter,
+		RateLimit:  md.RateLimit,
+	}
+	if remaining, err := strconv.Atoi(md.Header.Get(AttemptsRemainingHeader)); err == nil {
+		limits.AttemptsRemaining = &remaining
+	}
+
+	return limits
+}
+
+// MessageLink links to the message a token was sent in, e.g. an SMS message
+// that can be read with sms.Read, or an email that can be read with
+// ReadVerifyEmailMessage.
+type MessageLink struct {
+	HRef string
+	ID   string
+}
+
+// messageLinkFromHRef returns the MessageLink for href, which ends in the ID
+// of the message.
+func messageLinkFromHRef(href string) *MessageLink {
+	if href == "" {
+		return nil
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return &MessageLink{HRef: href}
+	}
+
+	return &MessageLink{
+		HRef: href,
+		ID:   u.Path[strings.LastIndex(u.Path, "/")+1:],
+	}
+}
+
+// FlashCall contains the metadata of a flash-call verification. The
+// recipient's phone is called from a number ending in the token, and the call
+// is disconnected before it is answered.
+type FlashCall struct {
+	CallerIDPrefix string // The caller ID digits preceding the token.
+	TokenLength    int    // The number of trailing caller ID digits that form the token.
+}
+
+// Token extracts the token from callerID, the number the recipient's phone
+// was called from. Non-digits, e.g. a leading "+", are ignored.
+func (f *FlashCall) Token(callerID string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, callerID)
+
+	if f.TokenLength <= 0 || len(digits) < f.TokenLength {
+		return "", fmt.Errorf("caller ID %q does not contain a token of %d digits", callerID, f.TokenLength)
+	}
+	if !strings.HasPrefix(digits, f.CallerIDPrefix) {
+		return "", fmt.Errorf("caller ID %q does not start with %q", callerID, f.CallerIDPrefix)
+	}
+
+	return digits[len(digits)-f.TokenLength:], nil
+}
+
+// IsVerified reports whether the token was verified.
+func (v *Verify) IsVerified() bool {
+	return v.Status == StatusVerified
+}
+
+// IsPending reports whether the token was sent, but not verified yet.
+func (v *Verify) IsPending() bool {
+	return v.Status == StatusSent
+}
+
+// IsFinal reports whether the Verify object reached a status that will not
+// change anymore.
+func (v *Verify) IsFinal() bool {
+	switch v.Status {
+	case StatusVerified, StatusFailed, StatusExpired, StatusDeleted:
+		return true
+	}
+	return false
+}
+
+// VerifyList represents a list of Verify objects.
+type VerifyList struct {
+	Offset     int
+	Limit      int
+	Count      int
+	TotalCount int
+	Links      map[string]*string
+	Items      []Verify
+}
+
+// ListParams provides filters and pagination for List. Zero values are not
+// used as filters.
+type ListParams struct {
+	Status    string    // Only list Verify objects with this status.
+	Recipient string    // Only list Verify objects for this recipient.
+	From      time.Time // Only list Verify objects created at or after From.
+	Until     time.Time // Only list Verify objects created before Until.
+	Limit     int
+	Offset    int
 }
 
 type VerifyMessage struct {
@@ -42,6 +219,28 @@ type Params struct {
 	Timeout     int
 	TokenLength int
 	Subject     string
+
+	// ChannelID is the ID of the WhatsApp channel to send the token from.
+	// Required for TypeWhatsApp.
+	ChannelID string
+
+	// TemplateName is the name of the approved WhatsApp message template the
+	// token is sent in. Required for TypeWhatsApp; the template's language is
+	// set through Language.
+	TemplateName string
+
+	// RedirectURL is the URL the recipient's device is redirected to once a
+	// silent verification completes. See ParseSilentRedirect.
+	RedirectURL string
+
+	// TemplateType is the content type of the Template of an email,
+	// TemplateTypeText (the default) or TemplateTypeHTML. Only for
+	// TypeEmail, like Subject.
+	TemplateType string
+
+	// timeoutDuration is the timeout as passed to WithTimeout, so timeouts of
+	// less than a second are not mistaken for the default.
+	timeoutDuration time.Duration
 }
 
 type verifyRequest struct {
@@ -57,73 +256,132 @@ type verifyRequest struct {
 	Timeout     int    `json:"timeout,omitempty"`
 	TokenLength int    `json:"tokenLength,omitempty"`
 	Subject     string `json:"subject,omitempty"`
+
+	ChannelID    string `json:"channelId,omitempty"`
+	TemplateName string `json:"templateName,omitempty"`
+	RedirectURL  string `json:"redirectUrl,omitempty"`
+	TemplateType string `json:"templateType,omitempty"`
+}
+
+type checkTokenRequest struct {
+	Token string `json:"token"`
 }
 
 // path represents the path to the Verify resource.
 const path = "verify"
 const emailMessagesPath = path + "/messages/email"
+const voiceMessagesPath = path + "/messages/voice"
 
 // Create generates a new One-Time-Password for one recipient.
-func Create(c *messagebird.Client, recipient string, params *Params) (*Verify, error) {
-	requestData, err := requestDataForVerify(recipient, params)
-	if err != nil {
-		return nil, err
-	}
+func Create(c messagebird.Requester, recipient string, params *Params) (*Verify, error) {
+	return CreateContext(requesterContext(c), c, recipient, params)
+}
 
-	verify := &Verify{}
-	if err := c.Request(verify, http.MethodPost, path, requestData); err != nil {
-		return nil, err
+// CreateWhatsApp generates a new One-Time-Password and sends it to recipient
+// over WhatsApp, using the approved template templateName on the WhatsApp
+// channel channelID.
+func CreateWhatsApp(c messagebird.Requester, recipient, channelID, templateName string, params *Params) (*Verify, error) {
+	whatsAppParams := &Params{}
+	if params != nil {
+		*whatsAppParams = *params
 	}
+	whatsAppParams.Type = TypeWhatsApp
+	whatsAppParams.ChannelID = channelID
+	whatsAppParams.TemplateName = templateName
 
-	return verify, nil
+	return Create(c, recipient, whatsAppParams)
 }
 
-// Delete deletes an existing Verify object by its ID.
-func Delete(c *messagebird.Client, id string) error {
-	return c.Request(nil, http.MethodDelete, path+"/"+id, nil)
-}
+// CreateEmail generates a new One-Time-Password and sends it to emailAddress.
+// The Originator param should be the email address the message is sent from.
+// Subject, Template and TemplateType can be used to customize the email;
+// Template must contain the %token placeholder. Read the sent email with
+// ReadVerifyEmailMessage.
+func CreateEmail(c messagebird.Requester, emailAddress string, params *Params) (*Verify, error) {
+	if _, err := mail.ParseAddress(emailAddress); err != nil {
+		return nil, fmt.Errorf("invalid email address %q: %w", emailAddress, err)
+	}
 
-// Read retrieves an existing Verify object by its ID.
-func Read(c *messagebird.Client, id string) (*Verify, error) {
-	verify := &Verify{}
+	emailParams := &Params{}
+	if params != nil {
+		*emailParams = *params
+	}
+	emailParams.Type = TypeEmail
 
-	if err := c.Request(verify, http.MethodGet, path+"/"+id, nil); err != nil {
-		return nil, err
+	return Create(c, emailAddress, emailParams)
+}
+
+// CreateFlashCall generates a new One-Time-Password and delivers it through a
+// flash call: recipient is called from a number whose last digits form the
+// token. TokenLength sets the number of digits, and Timeout how long the
+// token is valid. Use the returned Verify's FlashCall to extract the token
+// from the caller ID on the recipient's device.
+func CreateFlashCall(c messagebird.Requester, recipient string, params *Params) (*Verify, error) {
+	flashCallParams := &Params{}
+	if params != nil {
+		*flashCallParams = *params
 	}
+	flashCallParams.Type = TypeFlashCall
 
-	return verify, nil
+	return Create(c, recipient, flashCallParams)
 }
 
-// VerifyToken performs token value check against MessageBird API.
-func VerifyToken(c *messagebird.Client, id, token string) (*Verify, error) {
-	params := &url.Values{}
-	params.Set("token", token)
+// Resend sends the token of an existing Verify object again, e.g. when the
+// recipient didn't receive it. Unlike creating a new Verify object, this keeps
+// the token and its validity unchanged.
+func Resend(c messagebird.Requester, id string) (*Verify, error) {
+	return ResendContext(requesterContext(c), c, id)
+}
 
-	pathWithParams := path + "/" + id + "?" + params.Encode()
+// List retrieves Verify objects, e.g. the ones that are still pending, as a
+// VerifyList.
+func List(c messagebird.Requester, params *ListParams) (*VerifyList, error) {
+	return ListContext(requesterContext(c), c, params)
+}
 
-	verify := &Verify{}
-	if err := c.Request(verify, http.MethodGet, pathWithParams, nil); err != nil {
-		return nil, err
-	}
+// Delete deletes an existing Verify object by its ID.
+func Delete(c messagebird.Requester, id string) error {
+	return DeleteContext(requesterContext(c), c, id)
+}
 
-	return verify, nil
+// Read retrieves an existing Verify object by its ID.
+func Read(c messagebird.Requester, id string) (*Verify, error) {
+	return ReadContext(requesterContext(c), c, id)
 }
 
-func ReadVerifyEmailMessage(c *messagebird.Client, id string) (*VerifyMessage, error) {
+// VerifyToken performs token value check against MessageBird API.
+//
+// The token is sent in the query string, where it may end up in the logs of
+// proxies and servers. Use CheckToken to send it in the request body instead.
+//
+// If the token is not accepted, the error matches ErrInvalidToken,
+// ErrTokenExpired or ErrAlreadyProcessed. To tell these apart, the Verify
+// object is read after the token was rejected, so a rejected token costs a
+// second request, which counts against the rate limit like any other.
+func VerifyToken(c messagebird.Requester, id, token string) (*Verify, error) {
+	return VerifyTokenContext(requesterContext(c), c, id, token)
+}
 
-	messagePath := emailMessagesPath + "/" + id
+// CheckToken is like VerifyToken, but sends the token in the request body
+// rather than the URL, so it is not leaked through access logs. Rejected
+// tokens are mapped to the same errors, reading the Verify object as well.
+func CheckToken(c messagebird.Requester, id, token string) (*Verify, error) {
+	return CheckTokenContext(requesterContext(c), c, id, token)
+}
 
-	verifyMessage := &VerifyMessage{}
-	if err := c.Request(verifyMessage, http.MethodGet, messagePath, nil); err != nil {
-		return nil, err
-	}
+func ReadVerifyEmailMessage(c messagebird.Requester, id string) (*VerifyMessage, error) {
+	return ReadVerifyEmailMessageContext(requesterContext(c), c, id)
+}
 
-	return verifyMessage, nil
+// ReadVerifyVoiceMessage retrieves the voice message of a TTS verification by
+// its ID. Its Status tells whether the call was answered or failed.
+func ReadVerifyVoiceMessage(c messagebird.Requester, id string) (*VerifyMessage, error) {
+	return ReadVerifyVoiceMessageContext(requesterContext(c), c, id)
 }
 
 func requestDataForVerify(recipient string, params *Params) (*verifyRequest, error) {
-	if recipient == "" {
-		return nil, errors.New("recipient is required")
+	if err := params.ValidateRecipient(recipient); err != nil {
+		return nil, err
 	}
 
 	request := &verifyRequest{
@@ -133,6 +391,9 @@ func requestDataForVerify(recipient string, params *Params) (*verifyRequest, err
 	if params == nil {
 		return request, nil
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 
 	request.Originator = params.Originator
 	request.Reference = params.Reference
@@ -145,10 +406,43 @@ func requestDataForVerify(recipient string, params *Params) (*verifyRequest, err
 	request.Timeout = params.Timeout
 	request.TokenLength = params.TokenLength
 	request.Subject = params.Subject
+	request.ChannelID = params.ChannelID
+	request.TemplateName = params.TemplateName
+	request.RedirectURL = params.RedirectURL
+	request.TemplateType = params.TemplateType
 
 	return request, nil
 }
 
+// paramsForList converts the specified ListParams to url.Values.
+func paramsForList(params *ListParams) url.Values {
+	urlParams := url.Values{}
+	if params == nil {
+		return urlParams
+	}
+
+	if params.Status != "" {
+		urlParams.Set("status", params.Status)
+	}
+	if params.Recipient != "" {
+		urlParams.Set("recipient", params.Recipient)
+	}
+	if !params.From.IsZero() {
+		urlParams.Set("from", params.From.Format(time.RFC3339))
+	}
+	if !params.Until.IsZero() {
+		urlParams.Set("until", params.Until.Format(time.RFC3339))
+	}
+	if params.Limit != 0 {
+		urlParams.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset != 0 {
+		urlParams.Set("offset", strconv.Itoa(params.Offset))
+	}
+
+	return urlParams
+}
+
 /**
 The type of the Verify.Recipient object changed from int to string but the api still returns a recipent numeric value whne sms type is used.
 This was the best way to ensure backward compatibility with the previous versions
@@ -179,10 +473,13 @@ func (v *Verify) UnmarshalJSON(b []byte) error {
 		wrapper.Alias.Recipient = strconv.FormatFloat(asFloat, noExponent, precision, bitSize)
 	case string:
 		wrapper.Alias.Recipient = wrapper.Recipient.(string)
+	case nil:
+		// Stubs and partial objects may not have a recipient.
 	default:
 		return fmt.Errorf("recipient is unknown type %T", wrapper.Recipient)
 	}
 
 	*v = Verify(wrapper.Alias)
+	v.Message = messageLinkFromHRef(v.Messages["href"])
 	return nil
 }
@@ -21,6 +21,10 @@ func assertVerifyObject(t *testing.T, v *Verify) {
 	assert.Equal(t, "MyReference", v.Reference)
 	assert.Len(t, v.Messages, 1)
 	assert.Equal(t, "https://rest.messagebird.com/messages/c2bbd563759288aaf962910b56023756", v.Messages["href"])
+	if assert.NotNil(t, v.Message) {
+		assert.Equal(t, "https://rest.messagebird.com/messages/c2bbd563759288aaf962910b56023756", v.Message.HRef)
+		assert.Equal(t, "c2bbd563759288aaf962910b56023756", v.Message.ID)
+	}
 	assert.Equal(t, "sent", v.Status)
 
 	assert.Equal(t, "2017-05-26T20:06:07Z", v.CreatedDatetime.Format(time.RFC3339))
@@ -38,6 +42,121 @@ func TestCreate(t *testing.T) {
 	assertVerifyObject(t, v)
 }
 
+func TestCreateEmail(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyEmailObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := CreateEmail(client, "client@example.com", &Params{
+		Originator: "noreply@example.com",
+		Subject:    "Your verification code",
+		Template:   "Your code is %token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "client@example.com", v.Recipient)
+	assert.Equal(t, "https://rest.messagebird.com/verify/messages/email/8e515072e7f14b7d8c71ee13025c600d", v.Messages["href"])
+	assert.Equal(t, "8e515072e7f14b7d8c71ee13025c600d", v.Message.ID)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/verify")
+	assert.JSONEq(t, `{
+		"recipient": "client@example.com",
+		"originator": "noreply@example.com",
+		"type": "email",
+		"subject": "Your verification code",
+		"template": "Your code is %token"
+	}`, string(mbtest.Request.Body))
+
+	_, err = CreateEmail(client, "31612345678", nil)
+	assert.Error(t, err)
+}
+
+func TestCreateWhatsApp(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyWhatsAppObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := CreateWhatsApp(client, "31612345678", "619747f69cf940a98fb443140ce9aed2", "verification_code", &Params{Language: "en"})
+	assert.NoError(t, err)
+	assert.Equal(t, "31612345678", v.Recipient)
+	assert.Equal(t, "619747f69cf940a98fb443140ce9aed2", v.ChannelID)
+
+	assert.JSONEq(t, `{
+		"recipient": "31612345678",
+		"type": "whatsapp",
+		"language": "en",
+		"channelId": "619747f69cf940a98fb443140ce9aed2",
+		"templateName": "verification_code"
+	}`, string(mbtest.Request.Body))
+
+	_, err = Create(client, "31612345678", &Params{Type: TypeWhatsApp})
+	assert.Error(t, err)
+}
+
+func TestCreateFlashCall(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyFlashCallObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := CreateFlashCall(client, "31612345678", &Params{TokenLength: 4, Timeout: 60})
+	assert.NoError(t, err)
+	assert.Equal(t, "31612345678", v.Recipient)
+	if assert.NotNil(t, v.FlashCall) {
+		assert.Equal(t, "3197010", v.FlashCall.CallerIDPrefix)
+		assert.Equal(t, 4, v.FlashCall.TokenLength)
+	}
+
+	assert.JSONEq(t, `{
+		"recipient": "31612345678",
+		"type": "flashcall",
+		"timeout": 60,
+		"tokenLength": 4
+	}`, string(mbtest.Request.Body))
+
+	_, err = CreateFlashCall(client, "client@example.com", nil)
+	assert.Error(t, err)
+}
+
+func TestFlashCallToken(t *testing.T) {
+	f := &FlashCall{CallerIDPrefix: "3197010", TokenLength: 4}
+
+	token, err := f.Token("+31 970 10 1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", token)
+
+	_, err = f.Token("+31201234")
+	assert.Error(t, err)
+
+	_, err = f.Token("123")
+	assert.Error(t, err)
+}
+
+func TestResend(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := Resend(client, "15498233759288aaf929661v21936686")
+	assert.NoError(t, err)
+
+	assertVerifyObject(t, v)
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/verify/15498233759288aaf929661v21936686/resend")
+}
+
+func TestList(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyListObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	list, err := List(client, &ListParams{
+		Status: StatusSent,
+		From:   time.Date(2017, 5, 26, 0, 0, 0, 0, time.UTC),
+		Limit:  20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, list.TotalCount)
+	assert.Len(t, list.Items, 2)
+	assert.Equal(t, "31612345678", list.Items[0].Recipient)
+	assert.Equal(t, "client@example.com", list.Items[1].Recipient)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/verify")
+	assert.Equal(t, "from=2017-05-26T00%3A00%3A00Z&limit=20&status=sent", mbtest.Request.URL.RawQuery)
+}
+
 func TestDelete(t *testing.T) {
 	mbtest.WillReturn([]byte(""), http.StatusNoContent)
 	client := mbtest.Client(t)
@@ -69,6 +188,19 @@ func TestVerifyToken(t *testing.T) {
 	assertVerifyTokenObject(t, v)
 }
 
+func TestCheckToken(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyTokenObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := CheckToken(client, "a3f2edb23592d68163f9694v13904556", "123456")
+	assert.NoError(t, err)
+
+	assertVerifyTokenObject(t, v)
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/verify/a3f2edb23592d68163f9694v13904556")
+	assert.Empty(t, mbtest.Request.URL.RawQuery)
+	assert.JSONEq(t, `{"token":"123456"}`, string(mbtest.Request.Body))
+}
+
 func TestReadVerifyEmailMessage(t *testing.T) {
 
 	mbtest.WillReturnTestdata(t, "verifyEmailMessageObject.json", http.StatusOK)
@@ -82,6 +214,18 @@ func TestReadVerifyEmailMessage(t *testing.T) {
 	mbtest.AssertEndpointCalled(t, http.MethodGet, "/verify/messages/email/8e515072e7f14b7d8c71ee13025c600d")
 }
 
+func TestReadVerifyVoiceMessage(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "verifyVoiceMessageObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	v, err := ReadVerifyVoiceMessage(client, "c2bbd563759288aaf962910b56023756")
+	assert.NoError(t, err)
+	assert.Equal(t, "c2bbd563759288aaf962910b56023756", v.ID)
+	assert.Equal(t, "delivered", v.Status)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/verify/messages/voice/c2bbd563759288aaf962910b56023756")
+}
+
 func assertVerifyTokenObject(t *testing.T, v *Verify) {
 	assert.NotNil(t, v)
 	assert.Equal(t, "a3f2edb23592d68163f9694v13904556", v.ID)
@@ -91,6 +235,7 @@ func assertVerifyTokenObject(t *testing.T, v *Verify) {
 	assert.Len(t, v.Messages, 1)
 	assert.Equal(t, "https://rest.messagebird.com/messages/63b168423592d681641eb07b76226648", v.Messages["href"])
 	assert.Equal(t, "verified", v.Status)
+	assert.True(t, v.IsVerified())
 
 	assert.Equal(t, "2017-05-30T12:39:50Z", v.CreatedDatetime.Format(time.RFC3339))
 	assert.Equal(t, "2017-05-30T12:40:20Z", v.ValidUntilDatetime.Format(time.RFC3339))
@@ -123,3 +268,23 @@ func TestRequestDataForVerify(t *testing.T) {
 	assert.Equal(t, 20, requestData.Timeout)
 	assert.Equal(t, 8, requestData.TokenLength)
 }
+
+func TestStatusPredicates(t *testing.T) {
+	tests := []struct {
+		status                       string
+		isVerified, isPending, final bool
+	}{
+		{StatusSent, false, true, false},
+		{StatusVerified, true, false, true},
+		{StatusFailed, false, false, true},
+		{StatusExpired, false, false, true},
+		{StatusDeleted, false, false, true},
+	}
+
+	for _, tt := range tests {
+		v := &Verify{Status: tt.status}
+		assert.Equal(t, tt.isVerified, v.IsVerified(), tt.status)
+		assert.Equal(t, tt.isPending, v.IsPending(), tt.status)
+		assert.Equal(t, tt.final, v.IsFinal(), tt.status)
+	}
+}
@@ -2,6 +2,7 @@ package voice
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -106,43 +107,48 @@ func (call *Call) UnmarshalJSON(data []byte) error {
 // CallByID fetches a call by it's ID.
 //
 // An error is returned if no such call flow exists or is accessible.
-func CallByID(client *messagebird.Client, id string) (*Call, error) {
+func CallByID(client messagebird.Requester, id string) (*Call, error) {
 	var resp struct {
 		Data []Call `json:"data"`
 	}
 	if err := client.Request(&resp, http.MethodGet, apiRoot+"/calls/"+id, nil); err != nil {
 		return nil, err
 	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
 	return &resp.Data[0], nil
 }
 
 // Calls returns a Paginator which iterates over all Calls.
-func Calls(client *messagebird.Client) *Paginator {
+func Calls(client messagebird.Requester) *Paginator {
 	return newPaginator(client, apiRoot+"/calls/", reflect.TypeOf(Call{}))
 }
 
+// callWebhook is the webhook of a call, as sent by InitiateCall.
+type callWebhook struct {
+	URL   string `json:"url,omitempty"`
+	Token string `json:"token,omitempty"`
+}
+
 // InitiateCall initiates an outbound call.
 //
 // When placing a call, you pass the source (the caller ID), the destination
 // (the number/address that will be called), and the callFlow (the call flow to
 // execute when the call is answered).
-func InitiateCall(client *messagebird.Client, source, destination string, callflow CallFlow, webhook *Webhook) (*Call, error) {
+func InitiateCall(client messagebird.Requester, source, destination string, callflow CallFlow, webhook *Webhook) (*Call, error) {
 	body := struct {
-		Source      string   `json:"source"`
-		Destination string   `json:"destination"`
-		Callflow    CallFlow `json:"callflow"`
-		Webhook     struct {
-			URL   string `json:"url,omitempty"`
-			Token string `json:"token,omitempty"`
-		}
+		Source      string       `json:"source"`
+		Destination string       `json:"destination"`
+		Callflow    CallFlow     `json:"callflow"`
+		Webhook     *callWebhook `json:"webhook,omitempty"`
 	}{
 		Source:      source,
 		Destination: destination,
 		Callflow:    callflow,
 	}
 	if webhook != nil {
-		body.Webhook.URL = webhook.URL
-		body.Webhook.Token = webhook.Token
+		body.Webhook = &callWebhook{URL: webhook.URL, Token: webhook.Token}
 	}
 	var resp struct {
 		Data []Call `json:"data"`
@@ -150,17 +156,29 @@ func InitiateCall(client *messagebird.Client, source, destination string, callfl
 	if err := client.Request(&resp, http.MethodPost, apiRoot+"/calls", body); err != nil {
 		return nil, err
 	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
 	return &resp.Data[0], nil
 }
 
 // Delete deletes the Call.
 //
 // If the call is in progress, it hangs up all legs.
-func (call *Call) Delete(client *messagebird.Client) error {
-	return client.Request(nil, http.MethodDelete, apiRoot+"/calls/"+call.ID, nil)
+func (call *Call) Delete(client messagebird.Requester) error {
+	return HangupCall(client, call.ID)
+}
+
+// HangupCall deletes the call with the given ID, hanging up all its legs if
+// the call is in progress.
+func HangupCall(client messagebird.Requester, id string) error {
+	if id == "" {
+		return errors.New("id is required")
+	}
+	return client.Request(nil, http.MethodDelete, apiRoot+"/calls/"+id, nil)
 }
 
 // Legs returns a paginator over all Legs associated with a call.
-func (call *Call) Legs(client *messagebird.Client) *Paginator {
-	return newPaginator(client, fmt.Sprintf("%s/calls/%s/legs", apiRoot, call.ID), reflect.TypeOf(Leg{}))
+func (call *Call) Legs(client messagebird.Requester) *Paginator {
+	return Legs(client, call.ID)
 }
@@ -1,9 +1,11 @@
 package voice
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -61,3 +63,55 @@ func TestCallByID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, call.Source, fetchedCall.Source)
 }
+
+func assertCallObject(t *testing.T, call *Call) {
+	assert.Equal(t, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58", call.ID)
+	assert.Equal(t, CallStatusOngoing, call.Status)
+	assert.Equal(t, "31644556677", call.Source)
+	assert.Equal(t, "31612345678", call.Destination)
+	assert.Equal(t, "2017-02-16T10:52:00Z", call.CreatedAt.Format(time.RFC3339))
+	assert.Equal(t, "2017-02-16T10:52:04Z", call.UpdatedAt.Format(time.RFC3339))
+	assert.Nil(t, call.EndedAt)
+}
+
+func TestInitiateCallRequest(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "callObject.json", http.StatusCreated)
+	client := mbtest.Client(t)
+
+	callflow := CallFlow{Steps: []CallFlowStep{&CallFlowHangupStep{}}}
+	call, err := InitiateCall(client, "31644556677", "31612345678", callflow, &Webhook{URL: "https://example.com/calls", Token: "secret"})
+	assert.NoError(t, err)
+	assertCallObject(t, call)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/v1/calls")
+	assert.Contains(t, string(mbtest.Request.Body), `"source":"31644556677"`)
+	assert.Contains(t, string(mbtest.Request.Body), `"webhook":{"url":"https://example.com/calls","token":"secret"}`)
+}
+
+func TestCallByIDRequest(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "callObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	call, err := CallByID(client, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58")
+	assert.NoError(t, err)
+	assertCallObject(t, call)
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/calls/f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58")
+}
+
+func TestCallByIDEmptyResponse(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"data":[]}`), http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := CallByID(client, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58")
+	assert.EqualError(t, err, "empty response")
+}
+
+func TestHangupCall(t *testing.T) {
+	mbtest.WillReturn([]byte(""), http.StatusNoContent)
+	client := mbtest.Client(t)
+
+	assert.NoError(t, HangupCall(client, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58"))
+	mbtest.AssertEndpointCalled(t, http.MethodDelete, "/v1/calls/f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58")
+
+	assert.Error(t, HangupCall(client, ""))
+}
@@ -68,24 +68,11 @@ func (callflow *CallFlow) UnmarshalJSON(data []byte) error {
 		Steps: make([]CallFlowStep, len(stepTypeLookahead.Steps)),
 	}
 	for i, s := range stepTypeLookahead.Steps {
-		switch s.Action {
-		case "transfer":
-			raw.Steps[i] = &CallFlowTransferStep{}
-		case "say":
-			raw.Steps[i] = &CallFlowSayStep{}
-		case "play":
-			raw.Steps[i] = &CallFlowPlayStep{}
-		case "pause":
-			raw.Steps[i] = &CallFlowPauseStep{}
-		case "record":
-			raw.Steps[i] = &CallFlowRecordStep{}
-		case "fetchCallFlow":
-			raw.Steps[i] = &CallFlowFetchStep{}
-		case "hangup":
-			raw.Steps[i] = &CallFlowHangupStep{}
-		default:
-			return fmt.Errorf("unknown step action: %q", s.Action)
+		step, err := newCallFlowStep(s.Action)
+		if err != nil {
+			return err
 		}
+		raw.Steps[i] = step
 	}
 
 	if err := json.Unmarshal(data, &raw); err != nil {
@@ -113,31 +100,37 @@ func (callflow *CallFlow) UnmarshalJSON(data []byte) error {
 // CallFlowByID fetches a callflow by it's ID.
 //
 // An error is returned if no such call flow exists or is accessible.
-func CallFlowByID(client *messagebird.Client, id string) (*CallFlow, error) {
+func CallFlowByID(client messagebird.Requester, id string) (*CallFlow, error) {
 	var data struct {
 		Data []CallFlow `json:"data"`
 	}
 	if err := client.Request(&data, http.MethodGet, apiRoot+"/call-flows/"+id, nil); err != nil {
 		return nil, err
 	}
+	if len(data.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
 	return &data.Data[0], nil
 }
 
 // CallFlows returns a Paginator which iterates over all CallFlows.
-func CallFlows(client *messagebird.Client) *Paginator {
+func CallFlows(client messagebird.Requester) *Paginator {
 	return newPaginator(client, apiRoot+"/call-flows/", reflect.TypeOf(CallFlow{}))
 }
 
 // Create creates the callflow remotely.
 //
 // The callflow is updated in-place.
-func (callflow *CallFlow) Create(client *messagebird.Client) error {
+func (callflow *CallFlow) Create(client messagebird.Requester) error {
 	var data struct {
 		Data []CallFlow `json:"data"`
 	}
 	if err := client.Request(&data, http.MethodPost, apiRoot+"/call-flows/", callflow); err != nil {
 		return err
 	}
+	if len(data.Data) == 0 {
+		return fmt.Errorf("empty response")
+	}
 	*callflow = data.Data[0]
 	return nil
 }
@@ -145,27 +138,66 @@ func (callflow *CallFlow) Create(client *messagebird.Client) error {
 // Update updates the call flow by overwriting it.
 //
 // An error is returned if no such call flow exists or is accessible.
-func (callflow *CallFlow) Update(client *messagebird.Client) error {
+func (callflow *CallFlow) Update(client messagebird.Requester) error {
 	var data struct {
 		Data []CallFlow `json:"data"`
 	}
 	if err := client.Request(&data, http.MethodPut, apiRoot+"/call-flows/"+callflow.ID, callflow); err != nil {
 		return err
 	}
+	if len(data.Data) == 0 {
+		return fmt.Errorf("empty response")
+	}
 	*callflow = data.Data[0]
 	return nil
 }
 
 // Delete deletes the CallFlow.
-func (callflow *CallFlow) Delete(client *messagebird.Client) error {
+func (callflow *CallFlow) Delete(client messagebird.Requester) error {
 	return client.Request(nil, http.MethodDelete, apiRoot+"/call-flows/"+callflow.ID, nil)
 }
 
+// A CallFlowNumber is a number that is attached to a call flow: incoming calls
+// to the number are handled by the call flow.
+type CallFlowNumber struct {
+	ID         string    `json:"id"`
+	Number     string    `json:"number"`
+	CallFlowID string    `json:"callFlowId"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// AttachNumbers attaches the given numbers to the call flow, so incoming calls
+// to them are handled by it. Numbers that were attached to another call flow
+// are moved to this one.
+func (callflow *CallFlow) AttachNumbers(client messagebird.Requester, numbers ...string) ([]CallFlowNumber, error) {
+	if len(numbers) == 0 {
+		return nil, fmt.Errorf("at least 1 number is required")
+	}
+
+	body := struct {
+		Numbers []string `json:"numbers"`
+	}{numbers}
+	var data struct {
+		Data []CallFlowNumber `json:"data"`
+	}
+	if err := client.Request(&data, http.MethodPost, apiRoot+"/call-flows/"+callflow.ID+"/numbers", body); err != nil {
+		return nil, err
+	}
+	return data.Data, nil
+}
+
+// Numbers returns a Paginator which iterates over the CallFlowNumbers attached
+// to the call flow.
+func (callflow *CallFlow) Numbers(client messagebird.Requester) *Paginator {
+	return newPaginator(client, apiRoot+"/call-flows/"+callflow.ID+"/numbers", reflect.TypeOf(CallFlowNumber{}))
+}
+
 // A CallFlowStep is a single step that can be taken in a callflow.
 //
-// It can be any of CallflowTransferStep, CallFlowSayStep, CallFlowPlayStep,
+// It can be any of CallFlowTransferStep, CallFlowSayStep, CallFlowPlayStep,
 // CallFlowPauseStep, CallFlowRecordStep, CallFlowFetchStep,
-// CallFlowHangupStep.
+// CallFlowSendKeysStep, CallFlowHangupStep.
 //
 // This interface is provided for clarity and not meant to be implemented by
 // other (external) types.
@@ -418,7 +450,8 @@ type CallFlowRecordStep struct {
 	//
 	// Optional with the default value being 0 which imposes no limit.
 	//
-	// Truncated to seconds.
+	// The value is sent as a number of seconds, e.g. MaxLength: 10 for ten
+	// seconds. RecordMaxLength converts a duration.
 	MaxLength time.Duration
 
 	// The duration of a moment of silence allowed before a recording is
@@ -426,7 +459,8 @@ type CallFlowRecordStep struct {
 	//
 	// If you omit this parameter, silence detection is disabled.
 	//
-	// Truncated to seconds.
+	// The value is sent as a number of seconds, like MaxLength.
+	// RecordTimeout converts a duration.
 	Timeout time.Duration
 
 	// Key DTMF input to terminate recording.
@@ -547,6 +581,46 @@ func (step *CallFlowFetchStep) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// A CallFlowSendKeysStep sends DTMF tones, e.g. to navigate the menu of the
+// destination of a transferred call.
+type CallFlowSendKeysStep struct {
+	CallFlowStepBase
+
+	// The keys to send. Allowed characters are 0-9, #, * and w, which waits
+	// 500ms before the next key.
+	Keys string
+}
+
+type jsonCallFlowSendKeysStep struct {
+	CallFlowStepBase
+	Action  string `json:"action"`
+	Options struct {
+		Keys string `json:"keys"`
+	} `json:"options"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (step *CallFlowSendKeysStep) MarshalJSON() ([]byte, error) {
+	data := jsonCallFlowSendKeysStep{}
+	data.CallFlowStepBase = step.CallFlowStepBase
+	data.Action = "sendKeys"
+	data.Options.Keys = step.Keys
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (step *CallFlowSendKeysStep) UnmarshalJSON(data []byte) error {
+	var raw jsonCallFlowSendKeysStep
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*step = CallFlowSendKeysStep{
+		CallFlowStepBase: raw.CallFlowStepBase,
+		Keys:             raw.Options.Keys,
+	}
+	return nil
+}
+
 // A CallFlowHangupStep ends the call.
 type CallFlowHangupStep struct {
 	CallFlowStepBase
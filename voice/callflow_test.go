@@ -2,10 +2,12 @@ package voice
 
 import (
 	"encoding/json"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -246,3 +248,60 @@ func TestCallFlowList(t *testing.T) {
 	}
 	assert.NotEqual(t, 0, i)
 }
+
+func TestCallFlowUpdate(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "callFlowObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	callflow := &CallFlow{
+		ID:    "de3ed163-d5fc-45f4-b8c4-7eea7458c635",
+		Title: "Forward call to 31612345678",
+		Steps: []CallFlowStep{&CallFlowTransferStep{Destination: "31612345678"}},
+	}
+	assert.NoError(t, callflow.Update(client))
+	assert.Equal(t, "2017-03-06T15:02:38Z", callflow.UpdatedAt.Format(time.RFC3339))
+	assert.Len(t, callflow.Steps, 1)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPut, "/v1/call-flows/de3ed163-d5fc-45f4-b8c4-7eea7458c635")
+}
+
+func TestCallFlowByIDEmptyResponse(t *testing.T) {
+	mbtest.WillReturn([]byte(`{"data":[]}`), http.StatusOK)
+	client := mbtest.Client(t)
+
+	_, err := CallFlowByID(client, "de3ed163-d5fc-45f4-b8c4-7eea7458c635")
+	assert.EqualError(t, err, "empty response")
+}
+
+func TestCallFlowAttachNumbers(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "callFlowNumbersObject.json", http.StatusCreated)
+	client := mbtest.Client(t)
+
+	callflow := &CallFlow{ID: "de3ed163-d5fc-45f4-b8c4-7eea7458c635"}
+	numbers, err := callflow.AttachNumbers(client, "31612345678")
+	assert.NoError(t, err)
+	assert.Len(t, numbers, 1)
+	assert.Equal(t, "31612345678", numbers[0].Number)
+	assert.Equal(t, callflow.ID, numbers[0].CallFlowID)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/v1/call-flows/de3ed163-d5fc-45f4-b8c4-7eea7458c635/numbers")
+	assert.JSONEq(t, `{"numbers":["31612345678"]}`, string(mbtest.Request.Body))
+
+	_, err = callflow.AttachNumbers(client)
+	assert.Error(t, err)
+}
+
+func TestCallFlowNumbers(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "callFlowNumbersObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	callflow := &CallFlow{ID: "de3ed163-d5fc-45f4-b8c4-7eea7458c635"}
+	page, err := callflow.Numbers(client).NextPage()
+	assert.NoError(t, err)
+
+	numbers := page.([]CallFlowNumber)
+	assert.Len(t, numbers, 1)
+	assert.Equal(t, "13f38f34-7ff4-45b3-8783-8d5b1143f22b", numbers[0].ID)
+	assert.Equal(t, "2017-03-16T13:49:24Z", numbers[0].CreatedAt.Format(time.RFC3339))
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/call-flows/de3ed163-d5fc-45f4-b8c4-7eea7458c635/numbers")
+}
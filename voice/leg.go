@@ -3,6 +3,7 @@ package voice
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"time"
 
@@ -65,8 +66,9 @@ type Leg struct {
 	// outgoing (e.g. for transferring a call). Possible values: incoming,
 	// outgoing.
 	Direction LegDirection
-	// The cost of the leg. The amount relates to the currency parameter.
-	Cost float64
+	// The cost of the leg. The amount relates to the currency parameter. It is
+	// kept as returned by the API to avoid rounding errors.
+	Cost json.Number
 	// The three-letter currency code (ISO 4217) related to the cost of the
 	// leg.
 	Currency string
@@ -85,19 +87,19 @@ type Leg struct {
 }
 
 type jsonLeg struct {
-	ID          string  `json:"id"`
-	CallID      string  `json:"callID"`
-	Source      string  `json:"source"`
-	Destination string  `json:"destination"`
-	Status      string  `json:"status"`
-	Direction   string  `json:"direction"`
-	Cost        float64 `json:"cost"`
-	Currency    string  `json:"currency"`
-	Duration    int     `json:"duration"`
-	CreatedAt   string  `json:"createdAt"`
-	UpdatedAt   string  `json:"updatedAt"`
-	AnsweredAt  string  `json:"answeredAt,omitempty"`
-	EndedAt     string  `json:"endedAt,omitempty"`
+	ID          string      `json:"id"`
+	CallID      string      `json:"callID"`
+	Source      string      `json:"source"`
+	Destination string      `json:"destination"`
+	Status      string      `json:"status"`
+	Direction   string      `json:"direction"`
+	Cost        json.Number `json:"cost"`
+	Currency    string      `json:"currency"`
+	Duration    int         `json:"duration"`
+	CreatedAt   string      `json:"createdAt"`
+	UpdatedAt   string      `json:"updatedAt"`
+	AnsweredAt  string      `json:"answeredAt,omitempty"`
+	EndedAt     string      `json:"endedAt,omitempty"`
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -115,8 +117,8 @@ func (leg *Leg) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("unable to parse Leg UpdatedAt: %v", err)
 	}
 	var answeredAt *time.Time
-	if raw.EndedAt != "" {
-		aat, err := time.Parse(time.RFC3339, raw.EndedAt)
+	if raw.AnsweredAt != "" {
+		aat, err := time.Parse(time.RFC3339, raw.AnsweredAt)
 		if err != nil {
 			return fmt.Errorf("unable to parse Leg AnsweredAt: %v", err)
 		}
@@ -148,7 +150,26 @@ func (leg *Leg) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// ReadLeg fetches the leg with the given ID of the call with the given ID.
+func ReadLeg(client messagebird.Requester, callID, legID string) (*Leg, error) {
+	var resp struct {
+		Data []Leg `json:"data"`
+	}
+	if err := client.Request(&resp, http.MethodGet, fmt.Sprintf("%s/calls/%s/legs/%s", apiRoot, callID, legID), nil); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
+	return &resp.Data[0], nil
+}
+
+// Legs returns a paginator over all Legs of the call with the given ID.
+func Legs(client messagebird.Requester, callID string) *Paginator {
+	return newPaginator(client, fmt.Sprintf("%s/calls/%s/legs", apiRoot, callID), reflect.TypeOf(Leg{}))
+}
+
 // Recordings retrieves the Recording objects associated with a leg.
-func (leg *Leg) Recordings(client *messagebird.Client) *Paginator {
+func (leg *Leg) Recordings(client messagebird.Requester) *Paginator {
 	return newPaginator(client, fmt.Sprintf("%s/calls/%s/legs/%s/recordings", apiRoot, leg.CallID, leg.ID), reflect.TypeOf(Recording{}))
 }
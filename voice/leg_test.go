@@ -0,0 +1,63 @@
+package voice
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertLegObject(t *testing.T, leg *Leg) {
+	assert.Equal(t, "d4f07ab3-b17c-44a8-bcef-2b351311c28f", leg.ID)
+	assert.Equal(t, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58", leg.CallID)
+	assert.Equal(t, "31644556677", leg.Source)
+	assert.Equal(t, "31612345678", leg.Destination)
+	assert.Equal(t, LegStatusHangup, leg.Status)
+	assert.Equal(t, LegDirectionOutgoing, leg.Direction)
+	assert.Equal(t, json.Number("0.000249"), leg.Cost)
+	assert.Equal(t, "USD", leg.Currency)
+	assert.Equal(t, 31*time.Second, leg.Duration)
+	assert.Equal(t, "2017-02-16T10:52:05Z", leg.AnsweredAt.Format(time.RFC3339))
+	assert.Equal(t, "2017-02-16T10:52:36Z", leg.EndedAt.Format(time.RFC3339))
+}
+
+func TestReadLeg(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "legObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	leg, err := ReadLeg(client, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58", "d4f07ab3-b17c-44a8-bcef-2b351311c28f")
+	assert.NoError(t, err)
+	assertLegObject(t, leg)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/calls/f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58/legs/d4f07ab3-b17c-44a8-bcef-2b351311c28f")
+}
+
+func TestLegs(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "legObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	page, err := Legs(client, "f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58").NextPage()
+	assert.NoError(t, err)
+
+	legs := page.([]Leg)
+	assert.Len(t, legs, 1)
+	assertLegObject(t, &legs[0])
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/calls/f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58/legs")
+}
+
+func TestLegUnmarshalNotAnswered(t *testing.T) {
+	var leg Leg
+	err := json.Unmarshal([]byte(`{
+		"id": "d4f07ab3-b17c-44a8-bcef-2b351311c28f",
+		"status": "no_answer",
+		"createdAt": "2017-02-16T10:52:00Z",
+		"updatedAt": "2017-02-16T10:52:46Z",
+		"endedAt": "2017-02-16T10:52:36Z"
+	}`), &leg)
+	assert.NoError(t, err)
+	assert.Nil(t, leg.AnsweredAt)
+	assert.NotNil(t, leg.EndedAt)
+}
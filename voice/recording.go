@@ -1,12 +1,12 @@
 package voice
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"reflect"
-	"runtime"
 	"time"
 
 	messagebird "github.com/messagebird/go-rest-api/v7"
@@ -58,7 +58,7 @@ type Recording struct {
 type jsonRecording struct {
 	ID        string            `json:"id"`
 	Format    string            `json:"format"`
-	LegID     string            `json:"legID"`
+	LegID     string            `json:"legId"`
 	Status    string            `json:"status"`
 	Duration  int               `json:"duration"`
 	CreatedAt string            `json:"createdAt"`
@@ -67,7 +67,7 @@ type jsonRecording struct {
 }
 
 // ReadRecording fetches a single Recording based on its call ID, leg ID and the recording ID.
-func ReadRecording(c *messagebird.Client, callID, legID, id string) (*Recording, error) {
+func ReadRecording(c messagebird.Requester, callID, legID, id string) (*Recording, error) {
 	json := new(struct {
 		Data []*Recording `json:"data"`
 	})
@@ -76,12 +76,15 @@ func ReadRecording(c *messagebird.Client, callID, legID, id string) (*Recording,
 		apiRoot, callID, legID, id), nil); err != nil {
 		return nil, err
 	}
+	if len(json.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
 
 	return json.Data[0], nil
 }
 
 // Recordings returns a Paginator which iterates over Recordings.
-func Recordings(c *messagebird.Client, callID, legID string) *Paginator {
+func Recordings(c messagebird.Requester, callID, legID string) *Paginator {
 	return newPaginator(c, fmt.Sprintf("%s/calls/%s/legs/%s/recordings", apiRoot, callID,
 		legID), reflect.TypeOf(Recording{}))
 }
@@ -104,31 +107,53 @@ func (rec *Recording) UnmarshalJSON(data []byte) error {
 }
 
 // Transcriptions returns a paginator for retrieving all Transcription objects.
-func (rec *Recording) Transcriptions(client *messagebird.Client, callID string) *Paginator {
+func (rec *Recording) Transcriptions(client messagebird.Requester, callID string) *Paginator {
 	path := apiRoot + rec.Links["self"] + "/transcriptions"
 	return newPaginator(client, path, reflect.TypeOf(Transcription{}))
 }
 
 // Delete deletes a recording.
-func Delete(client *messagebird.Client, callID, legID, recordingID string) error {
+func Delete(client messagebird.Requester, callID, legID, recordingID string) error {
 	return client.Request(nil, http.MethodDelete, fmt.Sprintf("%s/calls/%s/legs/%s/recordings/%s", apiRoot, callID, legID, recordingID), nil)
 }
 
-// DownloadFile streams the recorded WAV file.
-func (rec *Recording) DownloadFile(client *messagebird.Client) (io.ReadCloser, error) {
-	req, err := http.NewRequest(http.MethodGet, apiRoot+rec.Links["file"], nil)
+// DownloadFile streams the recorded WAV file. The caller must close the
+// returned reader.
+func (rec *Recording) DownloadFile(client messagebird.Requester) (io.ReadCloser, error) {
+	return rec.openFile(requestContext(client), client)
+}
+
+// Download streams the recorded WAV file to w without buffering it in memory,
+// e.g. to archive it in object storage. It returns the number of bytes
+// written. Canceling ctx aborts the download.
+func (rec *Recording) Download(ctx context.Context, client messagebird.Requester, w io.Writer) (int64, error) {
+	r, err := rec.openFile(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	return io.Copy(w, r)
+}
+
+func (rec *Recording) openFile(ctx context.Context, client messagebird.Requester) (io.ReadCloser, error) {
+	file, ok := rec.Links["file"]
+	if !ok {
+		return nil, fmt.Errorf("recording %s has no file link", rec.ID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiRoot+file, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "audio/*")
-	req.Header.Set("Authorization", "AccessKey "+client.AccessKey)
-	req.Header.Set("User-Agent", "MessageBird/ApiClient/"+messagebird.ClientVersion+" Go/"+runtime.Version())
 
-	resp, err := client.HTTPClient.Do(req)
+	resp, err := doRaw(client, req)
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
 		return nil, fmt.Errorf("bad HTTP status: %d", resp.StatusCode)
 	}
 	return resp.Body, nil
@@ -1,6 +1,8 @@
 package voice
 
 import (
+	"bytes"
+	"context"
 	"io/ioutil"
 	"net/http"
 	"testing"
@@ -70,3 +72,59 @@ func TestRecordings(t *testing.T) {
 
 	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/calls/callid/legs/legid/recordings")
 }
+
+func TestRecordingDownload(t *testing.T) {
+	fileContents := []byte("this is not really a WAV file")
+	mbClient, stop := testRequest(http.StatusOK, fileContents)
+	defer stop()
+
+	rec := &Recording{
+		ID: "1337",
+		Links: map[string]string{
+			"file": "/yolo/swag.wav",
+		},
+	}
+
+	var buf bytes.Buffer
+	n, err := rec.Download(context.Background(), mbClient, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(fileContents)), n)
+	assert.Equal(t, fileContents, buf.Bytes())
+}
+
+func TestRecordingDownloadError(t *testing.T) {
+	mbClient, stop := testRequest(http.StatusNotFound, []byte("not found"))
+	defer stop()
+
+	rec := &Recording{
+		ID: "1337",
+		Links: map[string]string{
+			"file": "/yolo/swag.wav",
+		},
+	}
+
+	var buf bytes.Buffer
+	_, err := rec.Download(context.Background(), mbClient, &buf)
+	assert.EqualError(t, err, "bad HTTP status: 404")
+	assert.Zero(t, buf.Len())
+
+	_, err = (&Recording{ID: "1337"}).Download(context.Background(), mbClient, &buf)
+	assert.EqualError(t, err, "recording 1337 has no file link")
+}
+
+func TestRecordingDownloadCanceled(t *testing.T) {
+	mbClient, stop := testRequest(http.StatusOK, []byte("this is not really a WAV file"))
+	defer stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rec := &Recording{
+		ID: "1337",
+		Links: map[string]string{
+			"file": "/yolo/swag.wav",
+		},
+	}
+	_, err := rec.Download(ctx, mbClient, &bytes.Buffer{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
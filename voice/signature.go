@@ -0,0 +1,140 @@
+package voice
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	callbackTimestampHeader = "X-MessageBird-Request-Timestamp"
+	callbackSignatureHeader = "X-MessageBird-Signature"
+)
+
+// DefaultCallbackValidityWindow is the ValidityWindow of a CallbackValidator
+// that doesn't set one.
+const DefaultCallbackValidityWindow = 5 * time.Second
+
+// ErrInvalidCallbackSignature is returned by CallbackValidator.ValidRequest
+// for requests that were not signed with the webhook's token, or that are
+// outside the validity window.
+var ErrInvalidCallbackSignature = errors.New("invalid voice callback signature")
+
+// A CallbackValidator checks the signatures of the callbacks the Voice API
+// sends to a Webhook, e.g. when the status of a call changes.
+//
+// These callbacks are signed with the token of the webhook rather than with
+// the signing key used for other webhooks, so they can't be checked with
+// package signature. The signature is the base64 encoded HMAC-SHA256, keyed
+// with the token, of:
+//
+//	TIMESTAMP + "\n" + SORTED_QUERY_PARAMS + "\n" + SHA256(BODY)
+//
+// Use Validate to reject unsigned callbacks before they reach a handler:
+//
+//	validator := voice.NewCallbackValidator(webhook.Token)
+//	http.Handle("/voice/events", validator.Validate(eventsHandler))
+type CallbackValidator struct {
+	// Token is the token the webhook was created with.
+	Token string
+
+	// ValidityWindow is the time window around the current time in which
+	// the request timestamp must fall. It defaults to
+	// DefaultCallbackValidityWindow.
+	ValidityWindow time.Duration
+
+	now func() time.Time
+}
+
+// NewCallbackValidator returns a CallbackValidator for callbacks to a webhook
+// with the given token.
+func NewCallbackValidator(token string) *CallbackValidator {
+	return &CallbackValidator{Token: token}
+}
+
+// ValidRequest returns ErrInvalidCallbackSignature if r has no valid
+// signature. The body of r is read, and replaced so it can be read again.
+func (v *CallbackValidator) ValidRequest(r *http.Request) error {
+	ts := r.Header.Get(callbackTimestampHeader)
+	sig := r.Header.Get(callbackSignatureHeader)
+	if ts == "" || sig == "" {
+		return ErrInvalidCallbackSignature
+	}
+
+	var body []byte
+	if r.Body != nil {
+		b, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		body = b
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if !v.validTimestamp(ts) || !v.validSignature(ts, r.URL.RawQuery, body, sig) {
+		return ErrInvalidCallbackSignature
+	}
+	return nil
+}
+
+// Validate wraps h so that requests without a valid signature are rejected
+// with 401 Unauthorized before they reach h.
+func (v *CallbackValidator) Validate(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.ValidRequest(r); err != nil {
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (v *CallbackValidator) validTimestamp(ts string) bool {
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	window := v.ValidityWindow
+	if window <= 0 {
+		window = DefaultCallbackValidityWindow
+	}
+	now := time.Now
+	if v.now != nil {
+		now = v.now
+	}
+
+	diff := now().Sub(time.Unix(sec, 0))
+	return diff < window/2 && diff > -window/2
+}
+
+func (v *CallbackValidator) validSignature(ts, rawQuery string, body []byte, sig string) bool {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return false
+	}
+	got, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, v.sign(ts, query.Encode(), body))
+}
+
+// sign calculates the signature of a callback. The query must be encoded
+// with its keys sorted, as url.Values.Encode does.
+func (v *CallbackValidator) sign(ts, query string, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+
+	mac := hmac.New(sha256.New, []byte(v.Token))
+	mac.Write([]byte(ts + "\n" + query + "\n"))
+	mac.Write(bodyHash[:])
+	return mac.Sum(nil)
+}
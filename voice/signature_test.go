@@ -0,0 +1,84 @@
+package voice
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCallbackBody = `{"call":{"id":"f1aa71c0-8f2a-4fe8-b5ef-9a330454ef58","status":"ended"}}`
+
+func newCallbackRequest(t *testing.T, v *CallbackValidator, ts time.Time, query string) *http.Request {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodPost, "https://example.com/voice/events?"+query, strings.NewReader(testCallbackBody))
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	r.Header.Set(callbackTimestampHeader, timestamp)
+
+	sorted, err := url.ParseQuery(query)
+	assert.NoError(t, err)
+	r.Header.Set(callbackSignatureHeader, base64.StdEncoding.EncodeToString(v.sign(timestamp, sorted.Encode(), []byte(testCallbackBody))))
+	return r
+}
+
+func TestCallbackValidatorValidRequest(t *testing.T) {
+	now := time.Unix(1544544948, 0)
+	v := NewCallbackValidator("token")
+	v.now = func() time.Time { return now }
+
+	r := newCallbackRequest(t, v, now, "b=2&a=1")
+	assert.NoError(t, v.ValidRequest(r))
+
+	// The body can still be read by the handler.
+	body, err := ioutil.ReadAll(r.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, testCallbackBody, string(body))
+}
+
+func TestCallbackValidatorInvalidRequest(t *testing.T) {
+	now := time.Unix(1544544948, 0)
+	v := NewCallbackValidator("token")
+	v.now = func() time.Time { return now }
+
+	other := NewCallbackValidator("other-token")
+	assert.Equal(t, ErrInvalidCallbackSignature, v.ValidRequest(newCallbackRequest(t, other, now, "")))
+
+	assert.Equal(t, ErrInvalidCallbackSignature, v.ValidRequest(newCallbackRequest(t, v, now.Add(-10*time.Second), "")))
+	assert.Equal(t, ErrInvalidCallbackSignature, v.ValidRequest(newCallbackRequest(t, v, now.Add(10*time.Second), "")))
+
+	tampered := newCallbackRequest(t, v, now, "a=1")
+	tampered.URL.RawQuery = "a=2"
+	assert.Equal(t, ErrInvalidCallbackSignature, v.ValidRequest(tampered))
+
+	unsigned := httptest.NewRequest(http.MethodPost, "https://example.com/voice/events", strings.NewReader(testCallbackBody))
+	assert.Equal(t, ErrInvalidCallbackSignature, v.ValidRequest(unsigned))
+}
+
+func TestCallbackValidatorValidate(t *testing.T) {
+	now := time.Unix(1544544948, 0)
+	v := NewCallbackValidator("token")
+	v.now = func() time.Time { return now }
+
+	var called int
+	h := v.Validate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+	}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newCallbackRequest(t, v, now, ""))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, called)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newCallbackRequest(t, NewCallbackValidator("other-token"), now, ""))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, 1, called)
+}
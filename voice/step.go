@@ -0,0 +1,323 @@
+package voice
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// A TTSVoice is the voice a CallFlowSayStep pronounces its payload with.
+type TTSVoice string
+
+// Voices a payload can be pronounced with.
+const (
+	TTSVoiceMale   TTSVoice = "male"
+	TTSVoiceFemale TTSVoice = "female"
+)
+
+// A MachineBehavior determines what a CallFlowSayStep does when a machine
+// picks up the phone.
+type MachineBehavior string
+
+const (
+	// IfMachineContinue plays the message without checking for a machine.
+	IfMachineContinue MachineBehavior = "continue"
+	// IfMachineDelay waits until the machine stops talking before playing
+	// the message.
+	IfMachineDelay MachineBehavior = "delay"
+	// IfMachineHangup hangs up when a machine answers.
+	IfMachineHangup MachineBehavior = "hangup"
+)
+
+// A RecordSide is the side of a transferred call that is recorded.
+type RecordSide string
+
+const (
+	// RecordIn records the voice of the destination.
+	RecordIn RecordSide = "in"
+	// RecordOut records the voice of the source.
+	RecordOut RecordSide = "out"
+	// RecordBoth records the source and the destination individually.
+	RecordBoth RecordSide = "both"
+)
+
+// newCallFlowStep returns an empty step for the given action.
+func newCallFlowStep(action string) (CallFlowStep, error) {
+	switch action {
+	case "transfer":
+		return &CallFlowTransferStep{}, nil
+	case "say":
+		return &CallFlowSayStep{}, nil
+	case "play":
+		return &CallFlowPlayStep{}, nil
+	case "pause":
+		return &CallFlowPauseStep{}, nil
+	case "record":
+		return &CallFlowRecordStep{}, nil
+	case "fetchCallFlow":
+		return &CallFlowFetchStep{}, nil
+	case "sendKeys":
+		return &CallFlowSendKeysStep{}, nil
+	case "hangup":
+		return &CallFlowHangupStep{}, nil
+	default:
+		return nil, fmt.Errorf("unknown step action: %q", action)
+	}
+}
+
+// UnmarshalCallFlowStep decodes a single step of a call flow, returning the
+// CallFlowStep type that matches its action, e.g. *CallFlowSayStep for "say".
+func UnmarshalCallFlowStep(data []byte) (CallFlowStep, error) {
+	var lookahead struct {
+		Action string `json:"action"`
+	}
+	if err := json.Unmarshal(data, &lookahead); err != nil {
+		return nil, err
+	}
+	step, err := newCallFlowStep(lookahead.Action)
+	if err != nil {
+		return nil, err
+	}
+	if err := step.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return step, nil
+}
+
+// A StepOption sets a property all steps have, like their ID. It can be
+// passed to any of the step constructors.
+type StepOption func(*CallFlowStepBase)
+
+// StepID sets the ID of a step, so OnKeypressGoto of other steps can refer
+// to it.
+func StepID(id string) StepOption {
+	return func(base *CallFlowStepBase) { base.ID = id }
+}
+
+// OnKeypressGoto continues the call flow at the step with the given ID when
+// a key is pressed during the step.
+func OnKeypressGoto(stepID string) StepOption {
+	return func(base *CallFlowStepBase) { base.OnKeypressGoto = stepID }
+}
+
+// OnKeypressVar stores the key pressed during the step in the call flow
+// variable with the given name.
+func OnKeypressVar(name string) StepOption {
+	return func(base *CallFlowStepBase) { base.OnKeypressVar = name }
+}
+
+// StepCondition only executes the step if the call flow variable compares to
+// value with operator, e.g. StepCondition("dtmf", "==", "1").
+func StepCondition(variable, operator, value string) StepOption {
+	return func(base *CallFlowStepBase) {
+		base.Conditions = append(base.Conditions, struct {
+			Variable string `json:"variable"`
+			Operator string `json:"operator"`
+			Value    string `json:"value"`
+		}{variable, operator, value})
+	}
+}
+
+func (opt StepOption) applySay(step *CallFlowSayStep)           { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applyPlay(step *CallFlowPlayStep)         { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applyTransfer(step *CallFlowTransferStep) { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applyRecord(step *CallFlowRecordStep)     { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applyPause(step *CallFlowPauseStep)       { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applyFetch(step *CallFlowFetchStep)       { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applySendKeys(step *CallFlowSendKeysStep) { opt(&step.CallFlowStepBase) }
+func (opt StepOption) applyHangup(step *CallFlowHangupStep)     { opt(&step.CallFlowStepBase) }
+
+// A SayOption configures a step created with Say.
+type SayOption interface {
+	applySay(*CallFlowSayStep)
+}
+
+type sayOption func(*CallFlowSayStep)
+
+func (opt sayOption) applySay(step *CallFlowSayStep) { opt(step) }
+
+// SayVoice sets the voice the payload is pronounced with.
+func SayVoice(voice TTSVoice) SayOption {
+	return sayOption(func(step *CallFlowSayStep) { step.Voice = string(voice) })
+}
+
+// SayLanguage sets the language of the payload, e.g. "en-GB".
+func SayLanguage(language string) SayOption {
+	return sayOption(func(step *CallFlowSayStep) { step.Language = language })
+}
+
+// SayRepeat sets the number of times the payload is repeated, between 1 and
+// 10.
+func SayRepeat(repeat int) SayOption {
+	return sayOption(func(step *CallFlowSayStep) { step.Repeat = repeat })
+}
+
+// SayIfMachine sets what happens when a machine picks up the phone, and how
+// long to analyze whether it did. A timeout of 0 uses the API's default.
+func SayIfMachine(behavior MachineBehavior, timeout time.Duration) SayOption {
+	return sayOption(func(step *CallFlowSayStep) {
+		step.IfMachine = string(behavior)
+		step.MachineTimeout = timeout
+	})
+}
+
+// Say returns a step that pronounces payload.
+//
+//	voice.Say("Press 1 for sales", voice.SayLanguage("en-GB"), voice.SayVoice(voice.TTSVoiceFemale), voice.OnKeypressVar("choice"))
+func Say(payload string, opts ...SayOption) *CallFlowSayStep {
+	step := &CallFlowSayStep{Payload: payload}
+	for _, opt := range opts {
+		opt.applySay(step)
+	}
+	return step
+}
+
+// A PlayOption configures a step created with Play.
+type PlayOption interface {
+	applyPlay(*CallFlowPlayStep)
+}
+
+// Play returns a step that plays back the WAV file (8 kHz, 16 bit) at
+// mediaURL.
+func Play(mediaURL string, opts ...PlayOption) *CallFlowPlayStep {
+	step := &CallFlowPlayStep{Media: mediaURL}
+	for _, opt := range opts {
+		opt.applyPlay(step)
+	}
+	return step
+}
+
+// A TransferOption configures a step created with Transfer.
+type TransferOption interface {
+	applyTransfer(*CallFlowTransferStep)
+}
+
+type transferOption func(*CallFlowTransferStep)
+
+func (opt transferOption) applyTransfer(step *CallFlowTransferStep) { opt(step) }
+
+// TransferRecord records the given side of the transferred call.
+func TransferRecord(side RecordSide) TransferOption {
+	return transferOption(func(step *CallFlowTransferStep) { step.Record = string(side) })
+}
+
+// Transfer returns a step that transfers the call to destination, an E.164
+// formatted number or a SIP URI.
+func Transfer(destination string, opts ...TransferOption) *CallFlowTransferStep {
+	step := &CallFlowTransferStep{Destination: destination}
+	for _, opt := range opts {
+		opt.applyTransfer(step)
+	}
+	return step
+}
+
+// A RecordOption configures a step created with Record.
+type RecordOption interface {
+	applyRecord(*CallFlowRecordStep)
+}
+
+type recordOption func(*CallFlowRecordStep)
+
+func (opt recordOption) applyRecord(step *CallFlowRecordStep) { opt(step) }
+
+// RecordMaxLength limits the duration of the recording. It is truncated to
+// seconds.
+//
+// CallFlowRecordStep sends MaxLength and Timeout to the API as they are, so
+// the options store them as a number of seconds rather than as a duration.
+func RecordMaxLength(maxLength time.Duration) RecordOption {
+	return recordOption(func(step *CallFlowRecordStep) { step.MaxLength = maxLength / time.Second })
+}
+
+// RecordTimeout stops the recording after a moment of silence of the given
+// duration. It is truncated to seconds.
+func RecordTimeout(timeout time.Duration) RecordOption {
+	return recordOption(func(step *CallFlowRecordStep) { step.Timeout = timeout / time.Second })
+}
+
+// RecordFinishOnKey stops the recording when the given key is pressed:
+// "any", "#", "*" or "none".
+func RecordFinishOnKey(key string) RecordOption {
+	return recordOption(func(step *CallFlowRecordStep) { step.FinishOnKey = key })
+}
+
+// RecordTranscribe transcribes the recording in the given language when it
+// has finished.
+func RecordTranscribe(language TranscriptionLanguage) RecordOption {
+	return recordOption(func(step *CallFlowRecordStep) {
+		step.Transcribe = true
+		step.TranscribeLanguage = string(language)
+	})
+}
+
+// RecordOnFinish fetches a new call flow from url when the recording has
+// finished.
+func RecordOnFinish(url string) RecordOption {
+	return recordOption(func(step *CallFlowRecordStep) { step.OnFinish = url })
+}
+
+// Record returns a step that records the caller, e.g. to capture a response.
+func Record(opts ...RecordOption) *CallFlowRecordStep {
+	step := &CallFlowRecordStep{}
+	for _, opt := range opts {
+		opt.applyRecord(step)
+	}
+	return step
+}
+
+// A PauseOption configures a step created with Pause.
+type PauseOption interface {
+	applyPause(*CallFlowPauseStep)
+}
+
+// Pause returns a step that pauses silently for length, truncated to seconds.
+func Pause(length time.Duration, opts ...PauseOption) *CallFlowPauseStep {
+	step := &CallFlowPauseStep{Length: length}
+	for _, opt := range opts {
+		opt.applyPause(step)
+	}
+	return step
+}
+
+// A FetchCallFlowOption configures a step created with FetchCallFlow.
+type FetchCallFlowOption interface {
+	applyFetch(*CallFlowFetchStep)
+}
+
+// FetchCallFlow returns a step that continues the call with the call flow
+// fetched from url. Steps after it are ignored.
+func FetchCallFlow(url string, opts ...FetchCallFlowOption) *CallFlowFetchStep {
+	step := &CallFlowFetchStep{URL: url}
+	for _, opt := range opts {
+		opt.applyFetch(step)
+	}
+	return step
+}
+
+// A SendKeysOption configures a step created with SendKeys.
+type SendKeysOption interface {
+	applySendKeys(*CallFlowSendKeysStep)
+}
+
+// SendKeys returns a step that sends keys as DTMF tones.
+func SendKeys(keys string, opts ...SendKeysOption) *CallFlowSendKeysStep {
+	step := &CallFlowSendKeysStep{Keys: keys}
+	for _, opt := range opts {
+		opt.applySendKeys(step)
+	}
+	return step
+}
+
+// A HangupOption configures a step created with Hangup.
+type HangupOption interface {
+	applyHangup(*CallFlowHangupStep)
+}
+
+// Hangup returns a step that ends the call.
+func Hangup(opts ...HangupOption) *CallFlowHangupStep {
+	step := &CallFlowHangupStep{}
+	for _, opt := range opts {
+		opt.applyHangup(step)
+	}
+	return step
+}
@@ -0,0 +1,103 @@
+package voice
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleSay() {
+	callflow := CallFlow{
+		Title: "Support line",
+		Steps: []CallFlowStep{
+			Say("Press 1 for sales, or 2 for support",
+				SayLanguage("en-GB"),
+				SayVoice(TTSVoiceFemale),
+				OnKeypressVar("choice"),
+			),
+			Transfer("31612345678", StepCondition("choice", "==", "1")),
+			Transfer("31612345679", StepCondition("choice", "==", "2"), TransferRecord(RecordBoth)),
+			Hangup(),
+		},
+	}
+	_ = callflow
+}
+
+func TestStepConstructors(t *testing.T) {
+	say := Say("Hello", SayVoice(TTSVoiceMale), SayLanguage("en-US"), SayRepeat(2), SayIfMachine(IfMachineHangup, time.Second), StepID("1"))
+	assert.Equal(t, &CallFlowSayStep{
+		CallFlowStepBase: CallFlowStepBase{ID: "1"},
+		Payload:          "Hello",
+		Voice:            "male",
+		Language:         "en-US",
+		Repeat:           2,
+		IfMachine:        "hangup",
+		MachineTimeout:   time.Second,
+	}, say)
+
+	record := Record(RecordMaxLength(10*time.Second), RecordTimeout(5*time.Second), RecordFinishOnKey("#"), RecordTranscribe(TranscriptionLanguageDutch), RecordOnFinish("https://example.com/next"))
+	assert.Equal(t, &CallFlowRecordStep{
+		MaxLength:          10,
+		Timeout:            5,
+		FinishOnKey:        "#",
+		Transcribe:         true,
+		TranscribeLanguage: "nl-NL",
+		OnFinish:           "https://example.com/next",
+	}, record)
+
+	assert.Equal(t, &CallFlowTransferStep{Destination: "31612345678", Record: "in"}, Transfer("31612345678", TransferRecord(RecordIn)))
+	assert.Equal(t, &CallFlowPlayStep{Media: "https://example.com/hello.wav"}, Play("https://example.com/hello.wav"))
+	assert.Equal(t, &CallFlowPauseStep{Length: 2 * time.Second}, Pause(2*time.Second))
+	assert.Equal(t, &CallFlowFetchStep{URL: "https://example.com/flow"}, FetchCallFlow("https://example.com/flow"))
+	assert.Equal(t, &CallFlowSendKeysStep{Keys: "1w2#"}, SendKeys("1w2#"))
+	assert.Equal(t, &CallFlowHangupStep{CallFlowStepBase: CallFlowStepBase{OnKeypressGoto: "1"}}, Hangup(OnKeypressGoto("1")))
+}
+
+func TestStepJSON(t *testing.T) {
+	steps := []CallFlowStep{
+		Say("Hello", SayVoice(TTSVoiceFemale), StepID("1")),
+		Play("https://example.com/hello.wav", StepID("2")),
+		Transfer("31612345678", TransferRecord(RecordOut), StepCondition("choice", "==", "1")),
+		Record(RecordMaxLength(10*time.Second), RecordTranscribe(TranscriptionLanguageEnglishUS)),
+		Pause(3 * time.Second),
+		FetchCallFlow("https://example.com/flow"),
+		SendKeys("123#"),
+		Hangup(),
+	}
+
+	for _, step := range steps {
+		data, err := json.Marshal(step)
+		assert.NoError(t, err)
+
+		got, err := UnmarshalCallFlowStep(data)
+		assert.NoError(t, err)
+		assert.Equal(t, step, got)
+	}
+}
+
+func TestStepJSONOptions(t *testing.T) {
+	data, err := json.Marshal(Record(RecordMaxLength(10*time.Second), RecordTimeout(5*time.Second)))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"action": "record",
+		"options": {
+			"maxLength": 10,
+			"timeout": 5,
+			"finishOnKey": "",
+			"transcribe": false,
+			"transcribeLanguage": "",
+			"onFinish": ""
+		}
+	}`, string(data))
+
+	data, err = json.Marshal(SendKeys("1w2", StepID("keys")))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"keys","action":"sendKeys","options":{"keys":"1w2"}}`, string(data))
+}
+
+func TestUnmarshalCallFlowStepUnknownAction(t *testing.T) {
+	_, err := UnmarshalCallFlowStep([]byte(`{"action":"dance"}`))
+	assert.EqualError(t, err, `unknown step action: "dance"`)
+}
@@ -1,16 +1,63 @@
 package voice
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
-	"runtime"
+	"reflect"
 	"time"
 
 	messagebird "github.com/messagebird/go-rest-api/v7"
 )
 
+// DefaultTranscriptionPollInterval is how often WaitForTranscription fetches
+// the status of a transcription when no interval is given.
+const DefaultTranscriptionPollInterval = 5 * time.Second
+
+// ErrTranscriptionFailed is returned by WaitForTranscription when the
+// transcription failed.
+var ErrTranscriptionFailed = errors.New("transcription failed")
+
+// TranscriptionStatus enumerates all valid values for the status of a
+// transcription.
+type TranscriptionStatus string
+
+const (
+	// TranscriptionStatusCreated indicates that the transcription was
+	// requested but has not started yet.
+	TranscriptionStatusCreated TranscriptionStatus = "created"
+	// TranscriptionStatusTranscribing indicates that the recording is being
+	// transcribed.
+	TranscriptionStatusTranscribing TranscriptionStatus = "transcribing"
+	// TranscriptionStatusDone indicates that the transcription is completed
+	// and its contents may be downloaded.
+	TranscriptionStatusDone TranscriptionStatus = "done"
+	// TranscriptionStatusFailed indicates that the recording could not be
+	// transcribed.
+	TranscriptionStatusFailed TranscriptionStatus = "failed"
+)
+
+// TranscriptionLanguage is the language a recording is transcribed in.
+type TranscriptionLanguage string
+
+// Languages recordings can be transcribed in.
+const (
+	TranscriptionLanguageGerman              TranscriptionLanguage = "de-DE"
+	TranscriptionLanguageEnglishAustralia    TranscriptionLanguage = "en-AU"
+	TranscriptionLanguageEnglishUK           TranscriptionLanguage = "en-UK"
+	TranscriptionLanguageEnglishUS           TranscriptionLanguage = "en-US"
+	TranscriptionLanguageSpanish             TranscriptionLanguage = "es-ES"
+	TranscriptionLanguageSpanishLatinAmerica TranscriptionLanguage = "es-LA"
+	TranscriptionLanguageFrench              TranscriptionLanguage = "fr-FR"
+	TranscriptionLanguageItalian             TranscriptionLanguage = "it-IT"
+	TranscriptionLanguageDutch               TranscriptionLanguage = "nl-NL"
+	TranscriptionLanguagePortugueseBrazil    TranscriptionLanguage = "pt-BR"
+)
+
 // A Transcription is a textual representation of a recording as text.
 //
 // You can request an automated transcription for a recording by doing a POST
@@ -21,7 +68,9 @@ type Transcription struct {
 	// The ID of the recording that the transcription belongs to.
 	RecordingID string
 	// The status of the transcription. Possible values: created, transcribing, done, failed.
-	Status string
+	Status TranscriptionStatus
+	// The reason the transcription failed, if it did.
+	Error string
 	// The date-time the transcription was created/requested.
 	CreatedAt time.Time
 	// The date-time the transcription was last updated.
@@ -35,13 +84,21 @@ type Transcription struct {
 
 type jsonTranscription struct {
 	ID          string            `json:"id"`
-	RecordingID string            `json:"recordingID"`
+	RecordingID string            `json:"recordingId"`
 	Status      string            `json:"status"`
+	Error       *string           `json:"error"`
 	CreatedAt   string            `json:"createdAt"`
 	UpdatedAt   string            `json:"updatedAt"`
 	Links       map[string]string `json:"_links"`
 }
 
+// TranscriptionParams are the optional parameters of a transcription.
+type TranscriptionParams struct {
+	// Language is the language spoken in the recording. The API defaults to
+	// TranscriptionLanguageEnglishUS.
+	Language TranscriptionLanguage `json:"language,omitempty"`
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (trans *Transcription) UnmarshalJSON(data []byte) error {
 	var raw jsonTranscription
@@ -56,10 +113,15 @@ func (trans *Transcription) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return fmt.Errorf("unable to parse Transcription UpdatedAt: %v", err)
 	}
+	var transError string
+	if raw.Error != nil {
+		transError = *raw.Error
+	}
 	*trans = Transcription{
 		ID:          raw.ID,
 		RecordingID: raw.RecordingID,
-		Status:      raw.Status,
+		Status:      TranscriptionStatus(raw.Status),
+		Error:       transError,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 		links:       raw.Links,
@@ -70,36 +132,69 @@ func (trans *Transcription) UnmarshalJSON(data []byte) error {
 // Contents gets the transcription file.
 //
 // This is a plain text file.
-func (trans *Transcription) Contents(client *messagebird.Client) (string, error) {
-	req, err := http.NewRequest(http.MethodGet, apiRoot+trans.links["file"], nil)
+func (trans *Transcription) Contents(client messagebird.Requester) (string, error) {
+	r, err := trans.openFile(requestContext(client), client)
 	if err != nil {
 		return "", err
 	}
+	defer r.Close()
+
+	b, err := ioutil.ReadAll(r)
+	return string(b), err
+}
+
+// Download streams the transcription file to w. It returns the number of
+// bytes written.
+func (trans *Transcription) Download(ctx context.Context, client messagebird.Requester, w io.Writer) (int64, error) {
+	r, err := trans.openFile(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	return io.Copy(w, r)
+}
+
+func (trans *Transcription) openFile(ctx context.Context, client messagebird.Requester) (io.ReadCloser, error) {
+	file, ok := trans.links["file"]
+	if !ok {
+		return nil, fmt.Errorf("transcription %s has no file link", trans.ID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiRoot+file, nil)
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Accept", "text/plain")
-	req.Header.Set("Authorization", "AccessKey "+client.AccessKey)
-	req.Header.Set("User-Agent", "MessageBird/ApiClient/"+messagebird.ClientVersion+" Go/"+runtime.Version())
 
-	resp, err := client.HTTPClient.Do(req)
+	resp, err := doRaw(client, req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("bad HTTP status: %d", resp.StatusCode)
+		resp.Body.Close()
+		return nil, fmt.Errorf("bad HTTP status: %d", resp.StatusCode)
 	}
-
-	defer resp.Body.Close()
-	b, err := ioutil.ReadAll(resp.Body)
-	return string(b), err
+	return resp.Body, nil
 }
 
 // CreateTranscription creates a transcription request for an existing recording
-func CreateTranscription(client *messagebird.Client, callID string, legID string, recordingID string) (trans *Transcription, err error) {
-	var body struct{}
-	path := fmt.Sprintf("/calls/%s/legs/%s/recordings/%s/transcriptions", callID, legID, recordingID)
+func CreateTranscription(client messagebird.Requester, callID string, legID string, recordingID string) (trans *Transcription, err error) {
+	return CreateTranscriptionWithParams(client, callID, legID, recordingID, nil)
+}
+
+// CreateTranscriptionWithParams creates a transcription request for an
+// existing recording, e.g. in another language than English. Params may be
+// nil.
+func CreateTranscriptionWithParams(client messagebird.Requester, callID, legID, recordingID string, params *TranscriptionParams) (*Transcription, error) {
+	body := params
+	if body == nil {
+		body = &TranscriptionParams{}
+	}
 	var resp struct {
 		Data []Transcription `json:"data"`
 	}
-	if err := client.Request(&resp, http.MethodPost, apiRoot+path, body); err != nil {
+	if err := client.Request(&resp, http.MethodPost, transcriptionsPath(callID, legID, recordingID), body); err != nil {
 		return nil, err
 	}
 	if len(resp.Data) == 0 {
@@ -108,3 +203,73 @@ func CreateTranscription(client *messagebird.Client, callID string, legID string
 
 	return &resp.Data[0], nil
 }
+
+// ReadTranscription fetches a single Transcription of a recording.
+func ReadTranscription(client messagebird.Requester, callID, legID, recordingID, id string) (*Transcription, error) {
+	return readTranscription(requestContext(client), client, callID, legID, recordingID, id)
+}
+
+func readTranscription(ctx context.Context, client messagebird.Requester, callID, legID, recordingID, id string) (*Transcription, error) {
+	var resp struct {
+		Data []Transcription `json:"data"`
+	}
+	if err := messagebird.RequestContext(ctx, client, &resp, http.MethodGet, transcriptionsPath(callID, legID, recordingID)+"/"+id, nil); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return &resp.Data[0], nil
+}
+
+// Transcriptions returns a Paginator which iterates over the Transcriptions
+// of a recording.
+func Transcriptions(client messagebird.Requester, callID, legID, recordingID string) *Paginator {
+	return newPaginator(client, transcriptionsPath(callID, legID, recordingID), reflect.TypeOf(Transcription{}))
+}
+
+// WaitForTranscription polls the transcription with the given ID every
+// interval until it is done, and returns it. If the transcription failed, it
+// is returned along with ErrTranscriptionFailed. Interval defaults to
+// DefaultTranscriptionPollInterval if it is not positive.
+//
+//	trans, err := voice.CreateTranscription(client, callID, legID, recordingID)
+//	...
+//	trans, err = voice.WaitForTranscription(ctx, client, callID, legID, recordingID, trans.ID, 0)
+//	...
+//	text, err := trans.Contents(client)
+func WaitForTranscription(ctx context.Context, client messagebird.Requester, callID, legID, recordingID, id string, interval time.Duration) (*Transcription, error) {
+	if interval <= 0 {
+		interval = DefaultTranscriptionPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		trans, err := readTranscription(ctx, client, callID, legID, recordingID, id)
+		if err != nil {
+			return nil, err
+		}
+		switch trans.Status {
+		case TranscriptionStatusDone:
+			return trans, nil
+		case TranscriptionStatusFailed:
+			if trans.Error != "" {
+				return trans, fmt.Errorf("%w: %s", ErrTranscriptionFailed, trans.Error)
+			}
+			return trans, ErrTranscriptionFailed
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func transcriptionsPath(callID, legID, recordingID string) string {
+	return fmt.Sprintf("%s/calls/%s/legs/%s/recordings/%s/transcriptions", apiRoot, callID, legID, recordingID)
+}
@@ -1,9 +1,14 @@
 package voice
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
 	"github.com/stretchr/testify/assert"
@@ -43,3 +48,121 @@ func TestCreateTranscription(t *testing.T) {
 
 	mbtest.AssertEndpointCalled(t, http.MethodPost, fmt.Sprintf("/v1/calls/%s/legs/%s/recordings/%s/transcriptions", callID, legID, recordingID))
 }
+
+func TestCreateTranscriptionWithParams(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "transcriptObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	trans, err := CreateTranscriptionWithParams(client, "7777777", "88888888", "999999999", &TranscriptionParams{
+		Language: TranscriptionLanguageDutch,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "00000000-1111-2222-3333-444444444444", trans.ID)
+	assert.Equal(t, "55555555-6666-7777-8888-999999999999", trans.RecordingID)
+	assert.Equal(t, TranscriptionStatusDone, trans.Status)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/v1/calls/7777777/legs/88888888/recordings/999999999/transcriptions")
+	assert.JSONEq(t, `{"language":"nl-NL"}`, string(mbtest.Request.Body))
+}
+
+func TestReadTranscription(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "transcriptObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	trans, err := ReadTranscription(client, "7777777", "88888888", "999999999", "00000000-1111-2222-3333-444444444444")
+	assert.NoError(t, err)
+	assert.Equal(t, TranscriptionStatusDone, trans.Status)
+	assert.Empty(t, trans.Error)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/calls/7777777/legs/88888888/recordings/999999999/transcriptions/00000000-1111-2222-3333-444444444444")
+}
+
+func TestTranscriptions(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "transcriptObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	data, err := Transcriptions(client, "7777777", "88888888", "999999999").NextPage()
+	assert.NoError(t, err)
+	assert.Len(t, data.([]Transcription), 1)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/calls/7777777/legs/88888888/recordings/999999999/transcriptions")
+}
+
+func TestTranscriptionDownload(t *testing.T) {
+	text := "the quick brown fox jumps over the lazy dog"
+	mbClient, stop := testRequest(http.StatusOK, []byte(text))
+	defer stop()
+
+	trans := &Transcription{
+		ID: "1337",
+		links: map[string]string{
+			"file": "/yolo/swag.txt",
+		},
+	}
+	var buf bytes.Buffer
+	n, err := trans.Download(context.Background(), mbClient, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(text)), n)
+	assert.Equal(t, text, buf.String())
+
+	_, err = (&Transcription{ID: "1337"}).Contents(mbClient)
+	assert.EqualError(t, err, "transcription 1337 has no file link")
+}
+
+// statusRequester responds to each request with a transcription that has
+// the next status in statuses.
+type statusRequester struct {
+	statuses []TranscriptionStatus
+	requests int
+}
+
+func (r *statusRequester) Request(v interface{}, method, path string, data interface{}) error {
+	status := r.statuses[r.requests]
+	r.requests++
+
+	transError := "null"
+	if status == TranscriptionStatusFailed {
+		transError = `"unsupported audio"`
+	}
+
+	body := fmt.Sprintf(`{"data":[{"id":"transid","status":%q,"error":%s,"createdAt":"2011-01-01T02:03:04Z","updatedAt":"2011-01-02T03:04:05Z"}]}`, status, transError)
+	return json.Unmarshal([]byte(body), v)
+}
+
+func TestWaitForTranscription(t *testing.T) {
+	requester := &statusRequester{statuses: []TranscriptionStatus{
+		TranscriptionStatusCreated,
+		TranscriptionStatusTranscribing,
+		TranscriptionStatusDone,
+	}}
+
+	trans, err := WaitForTranscription(context.Background(), requester, "callid", "legid", "recid", "transid", time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, TranscriptionStatusDone, trans.Status)
+	assert.Equal(t, 3, requester.requests)
+}
+
+func TestWaitForTranscriptionFailed(t *testing.T) {
+	requester := &statusRequester{statuses: []TranscriptionStatus{
+		TranscriptionStatusTranscribing,
+		TranscriptionStatusFailed,
+	}}
+
+	trans, err := WaitForTranscription(context.Background(), requester, "callid", "legid", "recid", "transid", time.Millisecond)
+	assert.True(t, errors.Is(err, ErrTranscriptionFailed))
+	assert.EqualError(t, err, "transcription failed: unsupported audio")
+	assert.Equal(t, "unsupported audio", trans.Error)
+}
+
+func TestWaitForTranscriptionCanceled(t *testing.T) {
+	requester := &statusRequester{statuses: []TranscriptionStatus{
+		TranscriptionStatusTranscribing,
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := WaitForTranscription(ctx, requester, "callid", "legid", "recid", "transid", time.Hour)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 1, requester.requests)
+}
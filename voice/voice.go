@@ -1,8 +1,11 @@
 package voice
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	messagebird "github.com/messagebird/go-rest-api/v7"
@@ -50,3 +53,22 @@ func (e ErrorResponse) Error() string {
 func (e Error) Error() string {
 	return fmt.Sprintf("code: %d, message: %q", e.Code, e.Message)
 }
+
+// requestContext returns the context requests made through client are bound
+// to, if client has one.
+func requestContext(client messagebird.Requester) context.Context {
+	if cc, ok := client.(interface{ Context() context.Context }); ok {
+		return cc.Context()
+	}
+	return context.Background()
+}
+
+// doRaw sends req using client, which must be able to send raw HTTP requests
+// like *messagebird.Client does.
+func doRaw(client messagebird.Requester, req *http.Request) (*http.Response, error) {
+	doer, ok := client.(messagebird.Doer)
+	if !ok {
+		return nil, errors.New("client can not send raw HTTP requests")
+	}
+	return doer.Do(req)
+}
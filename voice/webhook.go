@@ -64,18 +64,34 @@ func (wh *Webhook) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type webhookRequest struct {
+	URL   string `json:"url"`
+	Token string `json:"token,omitempty"`
+}
+
+// ReadWebhook fetches the webhook with the given ID.
+func ReadWebhook(client messagebird.Requester, id string) (*Webhook, error) {
+	var resp struct {
+		Data []Webhook `json:"data"`
+	}
+	if err := client.Request(&resp, http.MethodGet, apiRoot+"/webhooks/"+id, nil); err != nil {
+		return nil, err
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
+	return &resp.Data[0], nil
+}
+
 // Webhooks returns a paginator over all webhooks.
-func Webhooks(client *messagebird.Client) *Paginator {
+func Webhooks(client messagebird.Requester) *Paginator {
 	return newPaginator(client, apiRoot+"/webhooks/", reflect.TypeOf(Webhook{}))
 }
 
 // CreateWebHook creates a new webhook the specified url that will be called
 // and security token.
-func CreateWebHook(client *messagebird.Client, url, token string) (*Webhook, error) {
-	data := struct {
-		URL   string `json:"url"`
-		Token string `json:"token,omitempty"`
-	}{
+func CreateWebHook(client messagebird.Requester, url, token string) (*Webhook, error) {
+	data := &webhookRequest{
 		URL:   url,
 		Token: token,
 	}
@@ -85,22 +101,32 @@ func CreateWebHook(client *messagebird.Client, url, token string) (*Webhook, err
 	if err := client.Request(&resp, http.MethodPost, apiRoot+"/webhooks/", data); err != nil {
 		return nil, err
 	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("empty response")
+	}
 	return &resp.Data[0], nil
 }
 
-// Update syncs hte local state of a webhook to the MessageBird API.
-func (wh *Webhook) Update(client *messagebird.Client) error {
+// Update syncs the URL and token of a webhook to the MessageBird API.
+func (wh *Webhook) Update(client messagebird.Requester) error {
+	body := &webhookRequest{
+		URL:   wh.URL,
+		Token: wh.Token,
+	}
 	var data struct {
 		Data []Webhook `json:"data"`
 	}
-	if err := client.Request(&data, http.MethodPut, apiRoot+"/webhooks/"+wh.ID, wh); err != nil {
+	if err := client.Request(&data, http.MethodPut, apiRoot+"/webhooks/"+wh.ID, body); err != nil {
 		return err
 	}
+	if len(data.Data) == 0 {
+		return fmt.Errorf("empty response")
+	}
 	*wh = data.Data[0]
 	return nil
 }
 
 // Delete deletes a webhook.
-func (wh *Webhook) Delete(client *messagebird.Client) error {
+func (wh *Webhook) Delete(client messagebird.Requester) error {
 	return client.Request(nil, http.MethodDelete, apiRoot+"/webhooks/"+wh.ID, nil)
 }
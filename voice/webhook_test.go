@@ -1,11 +1,83 @@
 package voice
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/messagebird/go-rest-api/v7/internal/mbtest"
 	"github.com/stretchr/testify/assert"
 )
 
+func assertWebhookObject(t *testing.T, wh *Webhook) {
+	assert.Equal(t, "534e1848-235f-482d-983d-e3e11a04f58a", wh.ID)
+	assert.Equal(t, "https://example.com/voice-webhook", wh.URL)
+	assert.Equal(t, "token", wh.Token)
+	assert.Equal(t, "2017-03-15T13:27:02Z", wh.CreatedAt.Format(time.RFC3339))
+	assert.Equal(t, "2017-03-15T13:28:01Z", wh.UpdatedAt.Format(time.RFC3339))
+}
+
+func TestCreateWebhookRequest(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "webhookObject.json", http.StatusCreated)
+	client := mbtest.Client(t)
+
+	wh, err := CreateWebHook(client, "https://example.com/voice-webhook", "token")
+	assert.NoError(t, err)
+	assertWebhookObject(t, wh)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPost, "/v1/webhooks/")
+	assert.JSONEq(t, `{"url":"https://example.com/voice-webhook","token":"token"}`, string(mbtest.Request.Body))
+}
+
+func TestReadWebhook(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "webhookObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	wh, err := ReadWebhook(client, "534e1848-235f-482d-983d-e3e11a04f58a")
+	assert.NoError(t, err)
+	assertWebhookObject(t, wh)
+
+	mbtest.AssertEndpointCalled(t, http.MethodGet, "/v1/webhooks/534e1848-235f-482d-983d-e3e11a04f58a")
+}
+
+func TestWebhooks(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "webhookObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	data, err := Webhooks(client).NextPage()
+	assert.NoError(t, err)
+
+	webhooks := data.([]Webhook)
+	assert.Len(t, webhooks, 1)
+	assertWebhookObject(t, &webhooks[0])
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	mbtest.WillReturnTestdata(t, "webhookObject.json", http.StatusOK)
+	client := mbtest.Client(t)
+
+	wh := &Webhook{
+		ID:    "534e1848-235f-482d-983d-e3e11a04f58a",
+		URL:   "https://example.com/voice-webhook",
+		Token: "token",
+	}
+	assert.NoError(t, wh.Update(client))
+	assertWebhookObject(t, wh)
+
+	mbtest.AssertEndpointCalled(t, http.MethodPut, "/v1/webhooks/534e1848-235f-482d-983d-e3e11a04f58a")
+	assert.JSONEq(t, `{"url":"https://example.com/voice-webhook","token":"token"}`, string(mbtest.Request.Body))
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	mbtest.WillReturn([]byte(""), http.StatusNoContent)
+	client := mbtest.Client(t)
+
+	wh := &Webhook{ID: "534e1848-235f-482d-983d-e3e11a04f58a"}
+	assert.NoError(t, wh.Delete(client))
+
+	mbtest.AssertEndpointCalled(t, http.MethodDelete, "/v1/webhooks/534e1848-235f-482d-983d-e3e11a04f58a")
+}
+
 func TestCreateWebhook(t *testing.T) {
 	mbClient, ok := testClient(t)
 	if !ok {
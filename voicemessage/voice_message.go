@@ -62,7 +62,7 @@ type voiceMessageRequest struct {
 const path = "voicemessages"
 
 // Read retrieves the information of an existing VoiceMessage.
-func Read(c *messagebird.Client, id string) (*VoiceMessage, error) {
+func Read(c messagebird.Requester, id string) (*VoiceMessage, error) {
 	message := &VoiceMessage{}
 	if err := c.Request(message, http.MethodGet, path+"/"+id, nil); err != nil {
 		return nil, err
@@ -72,7 +72,7 @@ func Read(c *messagebird.Client, id string) (*VoiceMessage, error) {
 }
 
 // List retrieves all VoiceMessages of the user.
-func List(c *messagebird.Client) (*VoiceMessageList, error) {
+func List(c messagebird.Requester) (*VoiceMessageList, error) {
 	messageList := &VoiceMessageList{}
 	if err := c.Request(messageList, http.MethodGet, path, nil); err != nil {
 		return nil, err
@@ -82,7 +82,7 @@ func List(c *messagebird.Client) (*VoiceMessageList, error) {
 }
 
 // Create a new voice message for one or more recipients.
-func Create(c *messagebird.Client, recipients []string, body string, params *Params) (*VoiceMessage, error) {
+func Create(c messagebird.Requester, recipients []string, body string, params *Params) (*VoiceMessage, error) {
 	requestData, err := requestDataForVoiceMessage(recipients, body, params)
 	if err != nil {
 		return nil, err
@@ -113,6 +113,12 @@ func requestDataForVoiceMessage(recipients []string, body string, params *Params
 		return request, nil
 	}
 
+	if params.Originator != "" {
+		if err := messagebird.ValidateOriginator(params.Originator); err != nil {
+			return nil, err
+		}
+	}
+
 	request.Originator = params.Originator
 	request.Reference = params.Reference
 	request.Language = params.Language
@@ -1,6 +1,7 @@
 package voicemessage
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 	"time"
@@ -124,3 +125,8 @@ func TestRequestDataForVoiceMessage(t *testing.T) {
 	assert.Equal(t, "continue", request.IfMachine)
 	assert.Equal(t, voiceParams.ScheduledDatetime.Format(time.RFC3339), request.ScheduledDatetime)
 }
+
+func TestRequestDataForVoiceMessageInvalidOriginator(t *testing.T) {
+	_, err := requestDataForVoiceMessage([]string{"31612345678"}, "MyBody", &Params{Originator: "MSG-BIRD"})
+	assert.True(t, errors.Is(err, messagebird.ErrInvalidParams))
+}